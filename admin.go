@@ -0,0 +1,133 @@
+package main
+
+import (
+	"context"
+	"crypto/subtle"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/AntonAverchenkov/cards-http-service/internal/api"
+	"github.com/AntonAverchenkov/cards-http-service/internal/state"
+	"github.com/deepmap/oapi-codegen/pkg/middleware"
+	"github.com/getkin/kin-openapi/openapi3filter"
+	"github.com/labstack/echo/v4"
+)
+
+const (
+	adminSecurityScheme = "AdminToken"
+	adminPageLimit      = 100
+)
+
+var errSessionNotFound = errors.New("there is no such session")
+
+// adminAuthenticator checks the bearer token of the admin api operations; the api is disabled without a token
+func adminAuthenticator(token string) openapi3filter.AuthenticationFunc {
+	return func(ctx context.Context, input *openapi3filter.AuthenticationInput) error {
+		if input.SecuritySchemeName != adminSecurityScheme {
+			return fmt.Errorf("unknown security scheme %q", input.SecuritySchemeName)
+		}
+
+		if token == "" {
+			return echo.NewHTTPError(http.StatusNotFound, "the admin api is disabled")
+		}
+
+		header := input.RequestValidationInput.Request.Header.Get(echo.HeaderAuthorization)
+		given := strings.TrimPrefix(header, "Bearer ")
+
+		if given == header || subtle.ConstantTimeCompare([]byte(given), []byte(token)) != 1 {
+			if ectx, ok := ctx.Value(middleware.EchoContextKey).(echo.Context); ok {
+				ectx.Response().Header().Set(echo.HeaderWWWAuthenticate, `Bearer realm="admin"`)
+			}
+
+			return echo.NewHTTPError(http.StatusUnauthorized, "the admin token is missing or invalid")
+		}
+
+		return nil
+	}
+}
+
+// (GET /admin/sessions) : list the sessions in memory ordered by their ids
+func (h *handlers) AdminListSessions(ctx echo.Context, params api.AdminListSessionsParams) error {
+	after, limit := "", adminPageLimit
+
+	if params.After != nil {
+		after = *params.After
+	}
+	if params.Limit != nil {
+		limit = *params.Limit
+	}
+
+	h.lock.Lock()
+	defer h.lock.Unlock()
+
+	sessions, more := h.sessions.List(after, limit)
+
+	page := api.AdminSessionPage{
+		Sessions: make([]api.AdminSessionSummary, 0, len(sessions)),
+	}
+
+	for _, session := range sessions {
+		page.Sessions = append(page.Sessions, api.AdminSessionSummary{
+			Id:   session.Id,
+			Size: len(session.Deck.Cards),
+		})
+	}
+
+	if more {
+		next := sessions[len(sessions)-1].Id
+		page.Next = &next
+	}
+
+	return JSON(ctx, http.StatusOK, page)
+}
+
+// (GET /admin/sessions/{id}) : get a session's deck
+func (h *handlers) AdminGetSession(ctx echo.Context, id api.SessionId) error {
+	h.lock.Lock()
+	defer h.lock.Unlock()
+
+	session, exists := h.sessions.Find(string(id))
+	if !exists {
+		return Problem(ctx, errSessionNotFound)
+	}
+
+	return JSON(ctx, http.StatusOK, fromSession(session))
+}
+
+// (DELETE /admin/sessions/{id}) : delete a session
+func (h *handlers) AdminDeleteSession(ctx echo.Context, id api.SessionId) error {
+	h.lock.Lock()
+	defer h.lock.Unlock()
+
+	deleted, err := h.sessions.Delete(string(id))
+	if err != nil {
+		return Problem(ctx, err)
+	}
+	if !deleted {
+		return Problem(ctx, errSessionNotFound)
+	}
+
+	return ctx.NoContent(http.StatusNoContent)
+}
+
+// (POST /admin/sessions/{id}/reset) : replace a session's deck with a new sorted one
+func (h *handlers) AdminResetSession(ctx echo.Context, id api.SessionId) error {
+	h.lock.Lock()
+	defer h.lock.Unlock()
+
+	session, exists := h.sessions.Reset(string(id))
+	if !exists {
+		return Problem(ctx, errSessionNotFound)
+	}
+
+	return JSON(ctx, http.StatusOK, fromSession(session))
+}
+
+func fromSession(session state.Session) api.AdminSession {
+	return api.AdminSession{
+		Id:    session.Id,
+		Cards: fromGameCards(session.Deck.Cards),
+	}
+}
@@ -4,4 +4,9 @@ package main
 //go:generate $GOPATH/bin/oapi-codegen --package api --generate server -o internal/api/server.go api.yaml
 //go:generate $GOPATH/bin/oapi-codegen --package api --generate spec   -o internal/api/spec.go   api.yaml
 
+//go:generate $GOPATH/bin/oapi-codegen --package client --generate types  -o client/types.go  api.yaml
+//go:generate $GOPATH/bin/oapi-codegen --package client --generate client -o client/client.go api.yaml
+
+//go:generate protoc --go_out=. --go_opt=paths=source_relative --go-grpc_out=. --go-grpc_opt=paths=source_relative cardspb/cards.proto
+
 //go:generate docker run -i yousan/swagger-yaml-to-html < api.yaml > doc/index.html
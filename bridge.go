@@ -0,0 +1,147 @@
+package main
+
+import (
+	"fmt"
+	"io/ioutil"
+	"net/http"
+
+	"github.com/AntonAverchenkov/cards-http-service/internal/api"
+	"github.com/AntonAverchenkov/cards-http-service/internal/game"
+	"github.com/AntonAverchenkov/cards-http-service/internal/game/bridge"
+	"github.com/labstack/echo/v4"
+)
+
+// pbnContentType is the content type of the PBN responses
+const pbnContentType = "text/plain; charset=utf-8"
+
+// (GET /bridge/deal?board={board}) : deal the deck's 52 cards into the four hands of a bridge board
+func (h *handlers) BridgeDeal(ctx echo.Context, params api.BridgeDealParams) error {
+	d, err := h.dealBridge(ctx, params.Board)
+	if err != nil {
+		return Problem(ctx, err)
+	}
+
+	return JSON(ctx, http.StatusOK, fromBridgeDeal(d))
+}
+
+// (GET /bridge/deal.pbn?board={board}) : deal the deck's 52 cards into a bridge board in the PBN format
+func (h *handlers) BridgeDealPbn(ctx echo.Context, params api.BridgeDealPbnParams) error {
+	d, err := h.dealBridge(ctx, params.Board)
+	if err != nil {
+		return Problem(ctx, err)
+	}
+
+	return ctx.Blob(http.StatusOK, pbnContentType, []byte(d.PBN()))
+}
+
+// (POST /bridge/deal.pbn) : arrange the deck so that it deals the bridge board specified in body
+func (h *handlers) BridgeImportPbn(ctx echo.Context) error {
+	body, err := ioutil.ReadAll(ctx.Request().Body)
+	if err != nil {
+		return Problem(ctx, err)
+	}
+
+	deals, err := bridge.ParsePBN(string(body))
+	if err != nil {
+		return Problem(ctx, err)
+	}
+
+	if len(deals) != 1 {
+		return Problem(ctx, echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("exactly one board is expected, got %d", len(deals))))
+	}
+
+	h.lock.Lock()
+	defer h.lock.Unlock()
+
+	session, err := h.fetchMutableSession(ctx)
+	if err != nil {
+		return Problem(ctx, err)
+	}
+
+	if composition := session.Deck.Composition(); composition != game.CompositionStandard {
+		return Problem(ctx, fmt.Errorf("%w: bridge needs all %d cards, while the deck is %s", game.ErrDeckShort, game.DeckCapacity, composition))
+	}
+
+	if err := session.Deck.Arrange(deals[0].Cards()); err != nil {
+		return Problem(ctx, err)
+	}
+
+	// the deck deals the imported hands for the board's number only
+	ctx.Response().Header().Set(echo.HeaderLocation, fmt.Sprintf("/bridge/deal?board=%d", deals[0].Board))
+
+	return JSON(ctx, http.StatusOK, fromBridgeDeal(deals[0]))
+}
+
+// (GET /bridge/boards?seed={seed}&first={first}&count={count}) : generate a set of bridge boards from a seed
+func (h *handlers) BridgeBoards(ctx echo.Context, params api.BridgeBoardsParams) error {
+	deals, err := bridgeSet(params.Seed, params.First, params.Count)
+	if err != nil {
+		return Problem(ctx, err)
+	}
+
+	result := make([]api.BridgeDeal, 0, len(deals))
+	for _, d := range deals {
+		result = append(result, fromBridgeDeal(d))
+	}
+
+	return JSON(ctx, http.StatusOK, result)
+}
+
+// (GET /bridge/boards.pbn?seed={seed}&first={first}&count={count}) : generate a set of bridge boards in the PBN format
+func (h *handlers) BridgeBoardsPbn(ctx echo.Context, params api.BridgeBoardsPbnParams) error {
+	deals, err := bridgeSet(params.Seed, params.First, params.Count)
+	if err != nil {
+		return Problem(ctx, err)
+	}
+
+	return ctx.Blob(http.StatusOK, pbnContentType, []byte(bridge.EncodePBN(deals)))
+}
+
+// dealBridge deals the board from the session's deck, leaving the cards in it
+func (h *handlers) dealBridge(ctx echo.Context, board *api.Board) (*bridge.Deal, error) {
+	h.lock.Lock()
+	defer h.lock.Unlock()
+
+	session, err := h.fetchSessionSetCookie(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	number := 1
+	if board != nil {
+		number = int(*board)
+	}
+
+	return bridge.New(number, session.Deck.Cards)
+}
+
+func bridgeSet(seed api.Seed, first *api.FirstBoard, count *api.BoardCount) ([]*bridge.Deal, error) {
+	from, boards := 1, 16
+	if first != nil {
+		from = int(*first)
+	}
+	if count != nil {
+		boards = int(*count)
+	}
+
+	return bridge.Set(int64(seed), from, boards)
+}
+
+func fromBridgeDeal(d *bridge.Deal) api.BridgeDeal {
+	result := api.BridgeDeal{
+		Board:      d.Board,
+		Dealer:     api.BridgeSeat(d.Dealer.String()),
+		Vulnerable: api.BridgeDealVulnerable(d.Vulnerable.String()),
+		Hands:      make([]api.BridgeHand, 0, len(d.Hands)),
+	}
+
+	for seat, hand := range d.Hands {
+		result.Hands = append(result.Hands, api.BridgeHand{
+			Seat:  api.BridgeSeat(bridge.Seat(seat).String()),
+			Cards: fromGameCards(hand),
+			Hcp:   bridge.HCP(hand),
+		})
+	}
+
+	return result
+}
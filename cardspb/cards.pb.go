@@ -0,0 +1,532 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        (unknown)
+// source: cardspb/cards.proto
+
+// The gRPC flavour of the cards-http-service rest api (see api.yaml).
+//
+// Each client gets its own deck which is identified by the 'session-id'
+// metadata key. Requests without it get a new session, whose id is returned
+// in the 'session-id' response header.
+
+package cardspb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type Suit int32
+
+const (
+	Suit_SUIT_UNSPECIFIED Suit = 0
+	Suit_SUIT_CLUBS       Suit = 1
+	Suit_SUIT_HEARTS      Suit = 2
+	Suit_SUIT_DIAMONDS    Suit = 3
+	Suit_SUIT_SPADES      Suit = 4
+)
+
+// Enum value maps for Suit.
+var (
+	Suit_name = map[int32]string{
+		0: "SUIT_UNSPECIFIED",
+		1: "SUIT_CLUBS",
+		2: "SUIT_HEARTS",
+		3: "SUIT_DIAMONDS",
+		4: "SUIT_SPADES",
+	}
+	Suit_value = map[string]int32{
+		"SUIT_UNSPECIFIED": 0,
+		"SUIT_CLUBS":       1,
+		"SUIT_HEARTS":      2,
+		"SUIT_DIAMONDS":    3,
+		"SUIT_SPADES":      4,
+	}
+)
+
+func (x Suit) Enum() *Suit {
+	p := new(Suit)
+	*p = x
+	return p
+}
+
+func (x Suit) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Suit) Descriptor() protoreflect.EnumDescriptor {
+	return file_cardspb_cards_proto_enumTypes[0].Descriptor()
+}
+
+func (Suit) Type() protoreflect.EnumType {
+	return &file_cardspb_cards_proto_enumTypes[0]
+}
+
+func (x Suit) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Suit.Descriptor instead.
+func (Suit) EnumDescriptor() ([]byte, []int) {
+	return file_cardspb_cards_proto_rawDescGZIP(), []int{0}
+}
+
+type Value int32
+
+const (
+	Value_VALUE_UNSPECIFIED Value = 0
+	Value_VALUE_ACE         Value = 1
+	Value_VALUE_TWO         Value = 2
+	Value_VALUE_THREE       Value = 3
+	Value_VALUE_FOUR        Value = 4
+	Value_VALUE_FIVE        Value = 5
+	Value_VALUE_SIX         Value = 6
+	Value_VALUE_SEVEN       Value = 7
+	Value_VALUE_EIGHT       Value = 8
+	Value_VALUE_NINE        Value = 9
+	Value_VALUE_TEN         Value = 10
+	Value_VALUE_JACK        Value = 11
+	Value_VALUE_QUEEN       Value = 12
+	Value_VALUE_KING        Value = 13
+)
+
+// Enum value maps for Value.
+var (
+	Value_name = map[int32]string{
+		0:  "VALUE_UNSPECIFIED",
+		1:  "VALUE_ACE",
+		2:  "VALUE_TWO",
+		3:  "VALUE_THREE",
+		4:  "VALUE_FOUR",
+		5:  "VALUE_FIVE",
+		6:  "VALUE_SIX",
+		7:  "VALUE_SEVEN",
+		8:  "VALUE_EIGHT",
+		9:  "VALUE_NINE",
+		10: "VALUE_TEN",
+		11: "VALUE_JACK",
+		12: "VALUE_QUEEN",
+		13: "VALUE_KING",
+	}
+	Value_value = map[string]int32{
+		"VALUE_UNSPECIFIED": 0,
+		"VALUE_ACE":         1,
+		"VALUE_TWO":         2,
+		"VALUE_THREE":       3,
+		"VALUE_FOUR":        4,
+		"VALUE_FIVE":        5,
+		"VALUE_SIX":         6,
+		"VALUE_SEVEN":       7,
+		"VALUE_EIGHT":       8,
+		"VALUE_NINE":        9,
+		"VALUE_TEN":         10,
+		"VALUE_JACK":        11,
+		"VALUE_QUEEN":       12,
+		"VALUE_KING":        13,
+	}
+)
+
+func (x Value) Enum() *Value {
+	p := new(Value)
+	*p = x
+	return p
+}
+
+func (x Value) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Value) Descriptor() protoreflect.EnumDescriptor {
+	return file_cardspb_cards_proto_enumTypes[1].Descriptor()
+}
+
+func (Value) Type() protoreflect.EnumType {
+	return &file_cardspb_cards_proto_enumTypes[1]
+}
+
+func (x Value) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Value.Descriptor instead.
+func (Value) EnumDescriptor() ([]byte, []int) {
+	return file_cardspb_cards_proto_rawDescGZIP(), []int{1}
+}
+
+type Card struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Value         Value                  `protobuf:"varint,1,opt,name=value,proto3,enum=cards.v1.Value" json:"value,omitempty"`
+	Suit          Suit                   `protobuf:"varint,2,opt,name=suit,proto3,enum=cards.v1.Suit" json:"suit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Card) Reset() {
+	*x = Card{}
+	mi := &file_cardspb_cards_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Card) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Card) ProtoMessage() {}
+
+func (x *Card) ProtoReflect() protoreflect.Message {
+	mi := &file_cardspb_cards_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Card.ProtoReflect.Descriptor instead.
+func (*Card) Descriptor() ([]byte, []int) {
+	return file_cardspb_cards_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Card) GetValue() Value {
+	if x != nil {
+		return x.Value
+	}
+	return Value_VALUE_UNSPECIFIED
+}
+
+func (x *Card) GetSuit() Suit {
+	if x != nil {
+		return x.Suit
+	}
+	return Suit_SUIT_UNSPECIFIED
+}
+
+// Cards are listed from the top of the deck to the bottom
+type Cards struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Cards         []*Card                `protobuf:"bytes,1,rep,name=cards,proto3" json:"cards,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Cards) Reset() {
+	*x = Cards{}
+	mi := &file_cardspb_cards_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Cards) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Cards) ProtoMessage() {}
+
+func (x *Cards) ProtoReflect() protoreflect.Message {
+	mi := &file_cardspb_cards_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Cards.ProtoReflect.Descriptor instead.
+func (*Cards) Descriptor() ([]byte, []int) {
+	return file_cardspb_cards_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *Cards) GetCards() []*Card {
+	if x != nil {
+		return x.Cards
+	}
+	return nil
+}
+
+type ShowRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ShowRequest) Reset() {
+	*x = ShowRequest{}
+	mi := &file_cardspb_cards_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ShowRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ShowRequest) ProtoMessage() {}
+
+func (x *ShowRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cardspb_cards_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ShowRequest.ProtoReflect.Descriptor instead.
+func (*ShowRequest) Descriptor() ([]byte, []int) {
+	return file_cardspb_cards_proto_rawDescGZIP(), []int{2}
+}
+
+type ShuffleRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ShuffleRequest) Reset() {
+	*x = ShuffleRequest{}
+	mi := &file_cardspb_cards_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ShuffleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ShuffleRequest) ProtoMessage() {}
+
+func (x *ShuffleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cardspb_cards_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ShuffleRequest.ProtoReflect.Descriptor instead.
+func (*ShuffleRequest) Descriptor() ([]byte, []int) {
+	return file_cardspb_cards_proto_rawDescGZIP(), []int{3}
+}
+
+type DealRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DealRequest) Reset() {
+	*x = DealRequest{}
+	mi := &file_cardspb_cards_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DealRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DealRequest) ProtoMessage() {}
+
+func (x *DealRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cardspb_cards_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DealRequest.ProtoReflect.Descriptor instead.
+func (*DealRequest) Descriptor() ([]byte, []int) {
+	return file_cardspb_cards_proto_rawDescGZIP(), []int{4}
+}
+
+type ReturnRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Card          *Card                  `protobuf:"bytes,1,opt,name=card,proto3" json:"card,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReturnRequest) Reset() {
+	*x = ReturnRequest{}
+	mi := &file_cardspb_cards_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReturnRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReturnRequest) ProtoMessage() {}
+
+func (x *ReturnRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cardspb_cards_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReturnRequest.ProtoReflect.Descriptor instead.
+func (*ReturnRequest) Descriptor() ([]byte, []int) {
+	return file_cardspb_cards_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ReturnRequest) GetCard() *Card {
+	if x != nil {
+		return x.Card
+	}
+	return nil
+}
+
+var File_cardspb_cards_proto protoreflect.FileDescriptor
+
+const file_cardspb_cards_proto_rawDesc = "" +
+	"\n" +
+	"\x13cardspb/cards.proto\x12\bcards.v1\"Q\n" +
+	"\x04Card\x12%\n" +
+	"\x05value\x18\x01 \x01(\x0e2\x0f.cards.v1.ValueR\x05value\x12\"\n" +
+	"\x04suit\x18\x02 \x01(\x0e2\x0e.cards.v1.SuitR\x04suit\"-\n" +
+	"\x05Cards\x12$\n" +
+	"\x05cards\x18\x01 \x03(\v2\x0e.cards.v1.CardR\x05cards\"\r\n" +
+	"\vShowRequest\"\x10\n" +
+	"\x0eShuffleRequest\"\r\n" +
+	"\vDealRequest\"3\n" +
+	"\rReturnRequest\x12\"\n" +
+	"\x04card\x18\x01 \x01(\v2\x0e.cards.v1.CardR\x04card*a\n" +
+	"\x04Suit\x12\x14\n" +
+	"\x10SUIT_UNSPECIFIED\x10\x00\x12\x0e\n" +
+	"\n" +
+	"SUIT_CLUBS\x10\x01\x12\x0f\n" +
+	"\vSUIT_HEARTS\x10\x02\x12\x11\n" +
+	"\rSUIT_DIAMONDS\x10\x03\x12\x0f\n" +
+	"\vSUIT_SPADES\x10\x04*\xee\x01\n" +
+	"\x05Value\x12\x15\n" +
+	"\x11VALUE_UNSPECIFIED\x10\x00\x12\r\n" +
+	"\tVALUE_ACE\x10\x01\x12\r\n" +
+	"\tVALUE_TWO\x10\x02\x12\x0f\n" +
+	"\vVALUE_THREE\x10\x03\x12\x0e\n" +
+	"\n" +
+	"VALUE_FOUR\x10\x04\x12\x0e\n" +
+	"\n" +
+	"VALUE_FIVE\x10\x05\x12\r\n" +
+	"\tVALUE_SIX\x10\x06\x12\x0f\n" +
+	"\vVALUE_SEVEN\x10\a\x12\x0f\n" +
+	"\vVALUE_EIGHT\x10\b\x12\x0e\n" +
+	"\n" +
+	"VALUE_NINE\x10\t\x12\r\n" +
+	"\tVALUE_TEN\x10\n" +
+	"\x12\x0e\n" +
+	"\n" +
+	"VALUE_JACK\x10\v\x12\x0f\n" +
+	"\vVALUE_QUEEN\x10\f\x12\x0e\n" +
+	"\n" +
+	"VALUE_KING\x10\r2\xcf\x01\n" +
+	"\x04Deck\x12.\n" +
+	"\x04Show\x12\x15.cards.v1.ShowRequest\x1a\x0f.cards.v1.Cards\x124\n" +
+	"\aShuffle\x12\x18.cards.v1.ShuffleRequest\x1a\x0f.cards.v1.Cards\x12-\n" +
+	"\x04Deal\x12\x15.cards.v1.DealRequest\x1a\x0e.cards.v1.Card\x122\n" +
+	"\x06Return\x12\x17.cards.v1.ReturnRequest\x1a\x0f.cards.v1.CardsB8Z6github.com/AntonAverchenkov/cards-http-service/cardspbb\x06proto3"
+
+var (
+	file_cardspb_cards_proto_rawDescOnce sync.Once
+	file_cardspb_cards_proto_rawDescData []byte
+)
+
+func file_cardspb_cards_proto_rawDescGZIP() []byte {
+	file_cardspb_cards_proto_rawDescOnce.Do(func() {
+		file_cardspb_cards_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_cardspb_cards_proto_rawDesc), len(file_cardspb_cards_proto_rawDesc)))
+	})
+	return file_cardspb_cards_proto_rawDescData
+}
+
+var file_cardspb_cards_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_cardspb_cards_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
+var file_cardspb_cards_proto_goTypes = []any{
+	(Suit)(0),              // 0: cards.v1.Suit
+	(Value)(0),             // 1: cards.v1.Value
+	(*Card)(nil),           // 2: cards.v1.Card
+	(*Cards)(nil),          // 3: cards.v1.Cards
+	(*ShowRequest)(nil),    // 4: cards.v1.ShowRequest
+	(*ShuffleRequest)(nil), // 5: cards.v1.ShuffleRequest
+	(*DealRequest)(nil),    // 6: cards.v1.DealRequest
+	(*ReturnRequest)(nil),  // 7: cards.v1.ReturnRequest
+}
+var file_cardspb_cards_proto_depIdxs = []int32{
+	1, // 0: cards.v1.Card.value:type_name -> cards.v1.Value
+	0, // 1: cards.v1.Card.suit:type_name -> cards.v1.Suit
+	2, // 2: cards.v1.Cards.cards:type_name -> cards.v1.Card
+	2, // 3: cards.v1.ReturnRequest.card:type_name -> cards.v1.Card
+	4, // 4: cards.v1.Deck.Show:input_type -> cards.v1.ShowRequest
+	5, // 5: cards.v1.Deck.Shuffle:input_type -> cards.v1.ShuffleRequest
+	6, // 6: cards.v1.Deck.Deal:input_type -> cards.v1.DealRequest
+	7, // 7: cards.v1.Deck.Return:input_type -> cards.v1.ReturnRequest
+	3, // 8: cards.v1.Deck.Show:output_type -> cards.v1.Cards
+	3, // 9: cards.v1.Deck.Shuffle:output_type -> cards.v1.Cards
+	2, // 10: cards.v1.Deck.Deal:output_type -> cards.v1.Card
+	3, // 11: cards.v1.Deck.Return:output_type -> cards.v1.Cards
+	8, // [8:12] is the sub-list for method output_type
+	4, // [4:8] is the sub-list for method input_type
+	4, // [4:4] is the sub-list for extension type_name
+	4, // [4:4] is the sub-list for extension extendee
+	0, // [0:4] is the sub-list for field type_name
+}
+
+func init() { file_cardspb_cards_proto_init() }
+func file_cardspb_cards_proto_init() {
+	if File_cardspb_cards_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_cardspb_cards_proto_rawDesc), len(file_cardspb_cards_proto_rawDesc)),
+			NumEnums:      2,
+			NumMessages:   6,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_cardspb_cards_proto_goTypes,
+		DependencyIndexes: file_cardspb_cards_proto_depIdxs,
+		EnumInfos:         file_cardspb_cards_proto_enumTypes,
+		MessageInfos:      file_cardspb_cards_proto_msgTypes,
+	}.Build()
+	File_cardspb_cards_proto = out.File
+	file_cardspb_cards_proto_goTypes = nil
+	file_cardspb_cards_proto_depIdxs = nil
+}
@@ -0,0 +1,255 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.6.2
+// - protoc             (unknown)
+// source: cardspb/cards.proto
+
+// The gRPC flavour of the cards-http-service rest api (see api.yaml).
+//
+// Each client gets its own deck which is identified by the 'session-id'
+// metadata key. Requests without it get a new session, whose id is returned
+// in the 'session-id' response header.
+
+package cardspb
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	Deck_Show_FullMethodName    = "/cards.v1.Deck/Show"
+	Deck_Shuffle_FullMethodName = "/cards.v1.Deck/Shuffle"
+	Deck_Deal_FullMethodName    = "/cards.v1.Deck/Deal"
+	Deck_Return_FullMethodName  = "/cards.v1.Deck/Return"
+)
+
+// DeckClient is the client API for Deck service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type DeckClient interface {
+	// Get the current state of the deck
+	Show(ctx context.Context, in *ShowRequest, opts ...grpc.CallOption) (*Cards, error)
+	// Permute the deck in an unbiased way
+	Shuffle(ctx context.Context, in *ShuffleRequest, opts ...grpc.CallOption) (*Cards, error)
+	// Deal the top card by removing it from the deck; fails with
+	// FAILED_PRECONDITION when the deck is empty
+	Deal(ctx context.Context, in *DealRequest, opts ...grpc.CallOption) (*Card, error)
+	// Return the given card to the back of the deck; fails with
+	// FAILED_PRECONDITION when the deck is full, ALREADY_EXISTS when the card
+	// is in the deck and INVALID_ARGUMENT when the card is not specified
+	Return(ctx context.Context, in *ReturnRequest, opts ...grpc.CallOption) (*Cards, error)
+}
+
+type deckClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewDeckClient(cc grpc.ClientConnInterface) DeckClient {
+	return &deckClient{cc}
+}
+
+func (c *deckClient) Show(ctx context.Context, in *ShowRequest, opts ...grpc.CallOption) (*Cards, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Cards)
+	err := c.cc.Invoke(ctx, Deck_Show_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *deckClient) Shuffle(ctx context.Context, in *ShuffleRequest, opts ...grpc.CallOption) (*Cards, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Cards)
+	err := c.cc.Invoke(ctx, Deck_Shuffle_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *deckClient) Deal(ctx context.Context, in *DealRequest, opts ...grpc.CallOption) (*Card, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Card)
+	err := c.cc.Invoke(ctx, Deck_Deal_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *deckClient) Return(ctx context.Context, in *ReturnRequest, opts ...grpc.CallOption) (*Cards, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Cards)
+	err := c.cc.Invoke(ctx, Deck_Return_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// DeckServer is the server API for Deck service.
+// All implementations must embed UnimplementedDeckServer
+// for forward compatibility.
+type DeckServer interface {
+	// Get the current state of the deck
+	Show(context.Context, *ShowRequest) (*Cards, error)
+	// Permute the deck in an unbiased way
+	Shuffle(context.Context, *ShuffleRequest) (*Cards, error)
+	// Deal the top card by removing it from the deck; fails with
+	// FAILED_PRECONDITION when the deck is empty
+	Deal(context.Context, *DealRequest) (*Card, error)
+	// Return the given card to the back of the deck; fails with
+	// FAILED_PRECONDITION when the deck is full, ALREADY_EXISTS when the card
+	// is in the deck and INVALID_ARGUMENT when the card is not specified
+	Return(context.Context, *ReturnRequest) (*Cards, error)
+	mustEmbedUnimplementedDeckServer()
+}
+
+// UnimplementedDeckServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedDeckServer struct{}
+
+func (UnimplementedDeckServer) Show(context.Context, *ShowRequest) (*Cards, error) {
+	return nil, status.Error(codes.Unimplemented, "method Show not implemented")
+}
+func (UnimplementedDeckServer) Shuffle(context.Context, *ShuffleRequest) (*Cards, error) {
+	return nil, status.Error(codes.Unimplemented, "method Shuffle not implemented")
+}
+func (UnimplementedDeckServer) Deal(context.Context, *DealRequest) (*Card, error) {
+	return nil, status.Error(codes.Unimplemented, "method Deal not implemented")
+}
+func (UnimplementedDeckServer) Return(context.Context, *ReturnRequest) (*Cards, error) {
+	return nil, status.Error(codes.Unimplemented, "method Return not implemented")
+}
+func (UnimplementedDeckServer) mustEmbedUnimplementedDeckServer() {}
+func (UnimplementedDeckServer) testEmbeddedByValue()              {}
+
+// UnsafeDeckServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to DeckServer will
+// result in compilation errors.
+type UnsafeDeckServer interface {
+	mustEmbedUnimplementedDeckServer()
+}
+
+func RegisterDeckServer(s grpc.ServiceRegistrar, srv DeckServer) {
+	// If the following call panics, it indicates UnimplementedDeckServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&Deck_ServiceDesc, srv)
+}
+
+func _Deck_Show_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ShowRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DeckServer).Show(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Deck_Show_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DeckServer).Show(ctx, req.(*ShowRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Deck_Shuffle_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ShuffleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DeckServer).Shuffle(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Deck_Shuffle_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DeckServer).Shuffle(ctx, req.(*ShuffleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Deck_Deal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DealRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DeckServer).Deal(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Deck_Deal_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DeckServer).Deal(ctx, req.(*DealRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Deck_Return_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReturnRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DeckServer).Return(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Deck_Return_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DeckServer).Return(ctx, req.(*ReturnRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// Deck_ServiceDesc is the grpc.ServiceDesc for Deck service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var Deck_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "cards.v1.Deck",
+	HandlerType: (*DeckServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Show",
+			Handler:    _Deck_Show_Handler,
+		},
+		{
+			MethodName: "Shuffle",
+			Handler:    _Deck_Shuffle_Handler,
+		},
+		{
+			MethodName: "Deal",
+			Handler:    _Deck_Deal_Handler,
+		},
+		{
+			MethodName: "Return",
+			Handler:    _Deck_Return_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "cardspb/cards.proto",
+}
@@ -0,0 +1,5901 @@
+// Package client provides primitives to interact with the openapi HTTP API.
+//
+// Code generated by github.com/deepmap/oapi-codegen DO NOT EDIT.
+package client
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/deepmap/oapi-codegen/pkg/runtime"
+)
+
+// RequestEditorFn  is the function signature for the RequestEditor callback function
+type RequestEditorFn func(ctx context.Context, req *http.Request) error
+
+// Doer performs HTTP requests.
+//
+// The standard http.Client implements this interface.
+type HttpRequestDoer interface {
+	Do(req *http.Request) (*http.Response, error)
+}
+
+// Client which conforms to the OpenAPI3 specification for this service.
+type Client struct {
+	// The endpoint of the server conforming to this interface, with scheme,
+	// https://api.deepmap.com for example. This can contain a path relative
+	// to the server, such as https://api.deepmap.com/dev-test, and all the
+	// paths in the swagger spec will be appended to the server.
+	Server string
+
+	// Doer for performing requests, typically a *http.Client with any
+	// customized settings, such as certificate chains.
+	Client HttpRequestDoer
+
+	// A list of callbacks for modifying requests which are generated before sending over
+	// the network.
+	RequestEditors []RequestEditorFn
+}
+
+// ClientOption allows setting custom parameters during construction
+type ClientOption func(*Client) error
+
+// Creates a new Client, with reasonable defaults
+func NewClient(server string, opts ...ClientOption) (*Client, error) {
+	// create a client with sane default values
+	client := Client{
+		Server: server,
+	}
+	// mutate client and add all optional params
+	for _, o := range opts {
+		if err := o(&client); err != nil {
+			return nil, err
+		}
+	}
+	// ensure the server URL always has a trailing slash
+	if !strings.HasSuffix(client.Server, "/") {
+		client.Server += "/"
+	}
+	// create httpClient, if not already present
+	if client.Client == nil {
+		client.Client = &http.Client{}
+	}
+	return &client, nil
+}
+
+// WithHTTPClient allows overriding the default Doer, which is
+// automatically created using http.Client. This is useful for tests.
+func WithHTTPClient(doer HttpRequestDoer) ClientOption {
+	return func(c *Client) error {
+		c.Client = doer
+		return nil
+	}
+}
+
+// WithRequestEditorFn allows setting up a callback function, which will be
+// called right before sending the request. This can be used to mutate the request.
+func WithRequestEditorFn(fn RequestEditorFn) ClientOption {
+	return func(c *Client) error {
+		c.RequestEditors = append(c.RequestEditors, fn)
+		return nil
+	}
+}
+
+// The interface specification for the client above.
+type ClientInterface interface {
+	// Index request
+	Index(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// AdminListSessions request
+	AdminListSessions(ctx context.Context, params *AdminListSessionsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// AdminDeleteSession request
+	AdminDeleteSession(ctx context.Context, id SessionId, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// AdminGetSession request
+	AdminGetSession(ctx context.Context, id SessionId, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// AdminResetSession request
+	AdminResetSession(ctx context.Context, id SessionId, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// BridgeBoards request
+	BridgeBoards(ctx context.Context, params *BridgeBoardsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// BridgeBoardsPbn request
+	BridgeBoardsPbn(ctx context.Context, params *BridgeBoardsPbnParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// BridgeDeal request
+	BridgeDeal(ctx context.Context, params *BridgeDealParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// BridgeDealPbn request
+	BridgeDealPbn(ctx context.Context, params *BridgeDealPbnParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// BridgeImportPbn request  with any body
+	BridgeImportPbnWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// CardRenderSvg request
+	CardRenderSvg(ctx context.Context, card string, params *CardRenderSvgParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeckShow request
+	DeckShow(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeckRenderSvg request
+	DeckRenderSvg(ctx context.Context, params *DeckRenderSvgParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeckCut request
+	DeckCut(ctx context.Context, params *DeckCutParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeckDealCard2 request
+	DeckDealCard2(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeckDealCard request
+	DeckDealCard(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeckDealAt request
+	DeckDealAt(ctx context.Context, params *DeckDealAtParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeckDealBottom request
+	DeckDealBottom(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeckDrawCard request  with any body
+	DeckDrawCardWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	DeckDrawCard(ctx context.Context, body DeckDrawCardJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeckInsertCard request  with any body
+	DeckInsertCardWithBody(ctx context.Context, params *DeckInsertCardParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	DeckInsertCard(ctx context.Context, params *DeckInsertCardParams, body DeckInsertCardJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeckOdds request
+	DeckOdds(ctx context.Context, params *DeckOddsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeckPeek request
+	DeckPeek(ctx context.Context, params *DeckPeekParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeckReset2 request
+	DeckReset2(ctx context.Context, params *DeckReset2Params, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeckReset request
+	DeckReset(ctx context.Context, params *DeckResetParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeckReturnCard2 request
+	DeckReturnCard2(ctx context.Context, params *DeckReturnCard2Params, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeckReturnCard request  with any body
+	DeckReturnCardWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	DeckReturnCard(ctx context.Context, body DeckReturnCardJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeckShuffle2 request
+	DeckShuffle2(ctx context.Context, params *DeckShuffle2Params, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeckShuffle request
+	DeckShuffle(ctx context.Context, params *DeckShuffleParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeckSort request
+	DeckSort(ctx context.Context, params *DeckSortParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeckStats request
+	DeckStats(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GoFishEnd request
+	GoFishEnd(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GoFishShow request
+	GoFishShow(ctx context.Context, params *GoFishShowParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GoFishStart request  with any body
+	GoFishStartWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	GoFishStart(ctx context.Context, body GoFishStartJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GoFishAsk request  with any body
+	GoFishAskWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	GoFishAsk(ctx context.Context, body GoFishAskJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// KlondikeEnd request
+	KlondikeEnd(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// KlondikeShow request
+	KlondikeShow(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// KlondikeStart request
+	KlondikeStart(ctx context.Context, params *KlondikeStartParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// KlondikeHint request
+	KlondikeHint(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// KlondikeMoves request
+	KlondikeMoves(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// KlondikeMove request  with any body
+	KlondikeMoveWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	KlondikeMove(ctx context.Context, body KlondikeMoveJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// WarEnd request
+	WarEnd(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// WarShow request
+	WarShow(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// WarStart request
+	WarStart(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// WarPlay request
+	WarPlay(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// WarStep request
+	WarStep(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// Healthz request
+	Healthz(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// Readyz request
+	Readyz(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// Version request
+	Version(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+}
+
+func (c *Client) Index(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewIndexRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) AdminListSessions(ctx context.Context, params *AdminListSessionsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAdminListSessionsRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) AdminDeleteSession(ctx context.Context, id SessionId, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAdminDeleteSessionRequest(c.Server, id)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) AdminGetSession(ctx context.Context, id SessionId, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAdminGetSessionRequest(c.Server, id)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) AdminResetSession(ctx context.Context, id SessionId, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAdminResetSessionRequest(c.Server, id)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) BridgeBoards(ctx context.Context, params *BridgeBoardsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewBridgeBoardsRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) BridgeBoardsPbn(ctx context.Context, params *BridgeBoardsPbnParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewBridgeBoardsPbnRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) BridgeDeal(ctx context.Context, params *BridgeDealParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewBridgeDealRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) BridgeDealPbn(ctx context.Context, params *BridgeDealPbnParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewBridgeDealPbnRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) BridgeImportPbnWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewBridgeImportPbnRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) CardRenderSvg(ctx context.Context, card string, params *CardRenderSvgParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCardRenderSvgRequest(c.Server, card, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) DeckShow(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeckShowRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) DeckRenderSvg(ctx context.Context, params *DeckRenderSvgParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeckRenderSvgRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) DeckCut(ctx context.Context, params *DeckCutParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeckCutRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) DeckDealCard2(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeckDealCard2Request(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) DeckDealCard(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeckDealCardRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) DeckDealAt(ctx context.Context, params *DeckDealAtParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeckDealAtRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) DeckDealBottom(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeckDealBottomRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) DeckDrawCardWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeckDrawCardRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) DeckDrawCard(ctx context.Context, body DeckDrawCardJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeckDrawCardRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) DeckInsertCardWithBody(ctx context.Context, params *DeckInsertCardParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeckInsertCardRequestWithBody(c.Server, params, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) DeckInsertCard(ctx context.Context, params *DeckInsertCardParams, body DeckInsertCardJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeckInsertCardRequest(c.Server, params, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) DeckOdds(ctx context.Context, params *DeckOddsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeckOddsRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) DeckPeek(ctx context.Context, params *DeckPeekParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeckPeekRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) DeckReset2(ctx context.Context, params *DeckReset2Params, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeckReset2Request(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) DeckReset(ctx context.Context, params *DeckResetParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeckResetRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) DeckReturnCard2(ctx context.Context, params *DeckReturnCard2Params, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeckReturnCard2Request(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) DeckReturnCardWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeckReturnCardRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) DeckReturnCard(ctx context.Context, body DeckReturnCardJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeckReturnCardRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) DeckShuffle2(ctx context.Context, params *DeckShuffle2Params, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeckShuffle2Request(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) DeckShuffle(ctx context.Context, params *DeckShuffleParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeckShuffleRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) DeckSort(ctx context.Context, params *DeckSortParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeckSortRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) DeckStats(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeckStatsRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GoFishEnd(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGoFishEndRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GoFishShow(ctx context.Context, params *GoFishShowParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGoFishShowRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GoFishStartWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGoFishStartRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GoFishStart(ctx context.Context, body GoFishStartJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGoFishStartRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GoFishAskWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGoFishAskRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GoFishAsk(ctx context.Context, body GoFishAskJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGoFishAskRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) KlondikeEnd(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewKlondikeEndRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) KlondikeShow(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewKlondikeShowRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) KlondikeStart(ctx context.Context, params *KlondikeStartParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewKlondikeStartRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) KlondikeHint(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewKlondikeHintRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) KlondikeMoves(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewKlondikeMovesRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) KlondikeMoveWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewKlondikeMoveRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) KlondikeMove(ctx context.Context, body KlondikeMoveJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewKlondikeMoveRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) WarEnd(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewWarEndRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) WarShow(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewWarShowRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) WarStart(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewWarStartRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) WarPlay(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewWarPlayRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) WarStep(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewWarStepRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) Healthz(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewHealthzRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) Readyz(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewReadyzRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) Version(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewVersionRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+// NewIndexRequest generates requests for Index
+func NewIndexRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/")
+	if operationPath[0] == '/' {
+		operationPath = operationPath[1:]
+	}
+	operationURL := url.URL{
+		Path: operationPath,
+	}
+
+	queryURL := serverURL.ResolveReference(&operationURL)
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewAdminListSessionsRequest generates requests for AdminListSessions
+func NewAdminListSessionsRequest(server string, params *AdminListSessionsParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/admin/sessions")
+	if operationPath[0] == '/' {
+		operationPath = operationPath[1:]
+	}
+	operationURL := url.URL{
+		Path: operationPath,
+	}
+
+	queryURL := serverURL.ResolveReference(&operationURL)
+
+	queryValues := queryURL.Query()
+
+	if params.After != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "after", runtime.ParamLocationQuery, *params.After); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	if params.Limit != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	queryURL.RawQuery = queryValues.Encode()
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewAdminDeleteSessionRequest generates requests for AdminDeleteSession
+func NewAdminDeleteSessionRequest(server string, id SessionId) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/admin/sessions/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = operationPath[1:]
+	}
+	operationURL := url.URL{
+		Path: operationPath,
+	}
+
+	queryURL := serverURL.ResolveReference(&operationURL)
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewAdminGetSessionRequest generates requests for AdminGetSession
+func NewAdminGetSessionRequest(server string, id SessionId) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/admin/sessions/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = operationPath[1:]
+	}
+	operationURL := url.URL{
+		Path: operationPath,
+	}
+
+	queryURL := serverURL.ResolveReference(&operationURL)
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewAdminResetSessionRequest generates requests for AdminResetSession
+func NewAdminResetSessionRequest(server string, id SessionId) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/admin/sessions/%s/reset", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = operationPath[1:]
+	}
+	operationURL := url.URL{
+		Path: operationPath,
+	}
+
+	queryURL := serverURL.ResolveReference(&operationURL)
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewBridgeBoardsRequest generates requests for BridgeBoards
+func NewBridgeBoardsRequest(server string, params *BridgeBoardsParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/bridge/boards")
+	if operationPath[0] == '/' {
+		operationPath = operationPath[1:]
+	}
+	operationURL := url.URL{
+		Path: operationPath,
+	}
+
+	queryURL := serverURL.ResolveReference(&operationURL)
+
+	queryValues := queryURL.Query()
+
+	if queryFrag, err := runtime.StyleParamWithLocation("form", true, "seed", runtime.ParamLocationQuery, params.Seed); err != nil {
+		return nil, err
+	} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+		return nil, err
+	} else {
+		for k, v := range parsed {
+			for _, v2 := range v {
+				queryValues.Add(k, v2)
+			}
+		}
+	}
+
+	if params.First != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "first", runtime.ParamLocationQuery, *params.First); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	if params.Count != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "count", runtime.ParamLocationQuery, *params.Count); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	queryURL.RawQuery = queryValues.Encode()
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewBridgeBoardsPbnRequest generates requests for BridgeBoardsPbn
+func NewBridgeBoardsPbnRequest(server string, params *BridgeBoardsPbnParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/bridge/boards.pbn")
+	if operationPath[0] == '/' {
+		operationPath = operationPath[1:]
+	}
+	operationURL := url.URL{
+		Path: operationPath,
+	}
+
+	queryURL := serverURL.ResolveReference(&operationURL)
+
+	queryValues := queryURL.Query()
+
+	if queryFrag, err := runtime.StyleParamWithLocation("form", true, "seed", runtime.ParamLocationQuery, params.Seed); err != nil {
+		return nil, err
+	} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+		return nil, err
+	} else {
+		for k, v := range parsed {
+			for _, v2 := range v {
+				queryValues.Add(k, v2)
+			}
+		}
+	}
+
+	if params.First != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "first", runtime.ParamLocationQuery, *params.First); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	if params.Count != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "count", runtime.ParamLocationQuery, *params.Count); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	queryURL.RawQuery = queryValues.Encode()
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewBridgeDealRequest generates requests for BridgeDeal
+func NewBridgeDealRequest(server string, params *BridgeDealParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/bridge/deal")
+	if operationPath[0] == '/' {
+		operationPath = operationPath[1:]
+	}
+	operationURL := url.URL{
+		Path: operationPath,
+	}
+
+	queryURL := serverURL.ResolveReference(&operationURL)
+
+	queryValues := queryURL.Query()
+
+	if params.Board != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "board", runtime.ParamLocationQuery, *params.Board); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	queryURL.RawQuery = queryValues.Encode()
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewBridgeDealPbnRequest generates requests for BridgeDealPbn
+func NewBridgeDealPbnRequest(server string, params *BridgeDealPbnParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/bridge/deal.pbn")
+	if operationPath[0] == '/' {
+		operationPath = operationPath[1:]
+	}
+	operationURL := url.URL{
+		Path: operationPath,
+	}
+
+	queryURL := serverURL.ResolveReference(&operationURL)
+
+	queryValues := queryURL.Query()
+
+	if params.Board != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "board", runtime.ParamLocationQuery, *params.Board); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	queryURL.RawQuery = queryValues.Encode()
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewBridgeImportPbnRequestWithBody generates requests for BridgeImportPbn with any type of body
+func NewBridgeImportPbnRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/bridge/deal.pbn")
+	if operationPath[0] == '/' {
+		operationPath = operationPath[1:]
+	}
+	operationURL := url.URL{
+		Path: operationPath,
+	}
+
+	queryURL := serverURL.ResolveReference(&operationURL)
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewCardRenderSvgRequest generates requests for CardRenderSvg
+func NewCardRenderSvgRequest(server string, card string, params *CardRenderSvgParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "card", runtime.ParamLocationPath, card)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/card/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = operationPath[1:]
+	}
+	operationURL := url.URL{
+		Path: operationPath,
+	}
+
+	queryURL := serverURL.ResolveReference(&operationURL)
+
+	queryValues := queryURL.Query()
+
+	if params.FaceDown != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "face_down", runtime.ParamLocationQuery, *params.FaceDown); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	queryURL.RawQuery = queryValues.Encode()
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewDeckShowRequest generates requests for DeckShow
+func NewDeckShowRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/cards")
+	if operationPath[0] == '/' {
+		operationPath = operationPath[1:]
+	}
+	operationURL := url.URL{
+		Path: operationPath,
+	}
+
+	queryURL := serverURL.ResolveReference(&operationURL)
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewDeckRenderSvgRequest generates requests for DeckRenderSvg
+func NewDeckRenderSvgRequest(server string, params *DeckRenderSvgParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/cards.svg")
+	if operationPath[0] == '/' {
+		operationPath = operationPath[1:]
+	}
+	operationURL := url.URL{
+		Path: operationPath,
+	}
+
+	queryURL := serverURL.ResolveReference(&operationURL)
+
+	queryValues := queryURL.Query()
+
+	if params.Fan != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "fan", runtime.ParamLocationQuery, *params.Fan); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	if params.FaceDown != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "face_down", runtime.ParamLocationQuery, *params.FaceDown); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	queryURL.RawQuery = queryValues.Encode()
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewDeckCutRequest generates requests for DeckCut
+func NewDeckCutRequest(server string, params *DeckCutParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/cards/cut")
+	if operationPath[0] == '/' {
+		operationPath = operationPath[1:]
+	}
+	operationURL := url.URL{
+		Path: operationPath,
+	}
+
+	queryURL := serverURL.ResolveReference(&operationURL)
+
+	queryValues := queryURL.Query()
+
+	if queryFrag, err := runtime.StyleParamWithLocation("form", true, "count", runtime.ParamLocationQuery, params.Count); err != nil {
+		return nil, err
+	} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+		return nil, err
+	} else {
+		for k, v := range parsed {
+			for _, v2 := range v {
+				queryValues.Add(k, v2)
+			}
+		}
+	}
+
+	queryURL.RawQuery = queryValues.Encode()
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewDeckDealCard2Request generates requests for DeckDealCard2
+func NewDeckDealCard2Request(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/cards/deal")
+	if operationPath[0] == '/' {
+		operationPath = operationPath[1:]
+	}
+	operationURL := url.URL{
+		Path: operationPath,
+	}
+
+	queryURL := serverURL.ResolveReference(&operationURL)
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewDeckDealCardRequest generates requests for DeckDealCard
+func NewDeckDealCardRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/cards/deal")
+	if operationPath[0] == '/' {
+		operationPath = operationPath[1:]
+	}
+	operationURL := url.URL{
+		Path: operationPath,
+	}
+
+	queryURL := serverURL.ResolveReference(&operationURL)
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewDeckDealAtRequest generates requests for DeckDealAt
+func NewDeckDealAtRequest(server string, params *DeckDealAtParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/cards/deal/at")
+	if operationPath[0] == '/' {
+		operationPath = operationPath[1:]
+	}
+	operationURL := url.URL{
+		Path: operationPath,
+	}
+
+	queryURL := serverURL.ResolveReference(&operationURL)
+
+	queryValues := queryURL.Query()
+
+	if queryFrag, err := runtime.StyleParamWithLocation("form", true, "index", runtime.ParamLocationQuery, params.Index); err != nil {
+		return nil, err
+	} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+		return nil, err
+	} else {
+		for k, v := range parsed {
+			for _, v2 := range v {
+				queryValues.Add(k, v2)
+			}
+		}
+	}
+
+	queryURL.RawQuery = queryValues.Encode()
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewDeckDealBottomRequest generates requests for DeckDealBottom
+func NewDeckDealBottomRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/cards/deal/bottom")
+	if operationPath[0] == '/' {
+		operationPath = operationPath[1:]
+	}
+	operationURL := url.URL{
+		Path: operationPath,
+	}
+
+	queryURL := serverURL.ResolveReference(&operationURL)
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewDeckDrawCardRequest calls the generic DeckDrawCard builder with application/json body
+func NewDeckDrawCardRequest(server string, body DeckDrawCardJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewDeckDrawCardRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewDeckDrawCardRequestWithBody generates requests for DeckDrawCard with any type of body
+func NewDeckDrawCardRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/cards/draw")
+	if operationPath[0] == '/' {
+		operationPath = operationPath[1:]
+	}
+	operationURL := url.URL{
+		Path: operationPath,
+	}
+
+	queryURL := serverURL.ResolveReference(&operationURL)
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewDeckInsertCardRequest calls the generic DeckInsertCard builder with application/json body
+func NewDeckInsertCardRequest(server string, params *DeckInsertCardParams, body DeckInsertCardJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewDeckInsertCardRequestWithBody(server, params, "application/json", bodyReader)
+}
+
+// NewDeckInsertCardRequestWithBody generates requests for DeckInsertCard with any type of body
+func NewDeckInsertCardRequestWithBody(server string, params *DeckInsertCardParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/cards/insert")
+	if operationPath[0] == '/' {
+		operationPath = operationPath[1:]
+	}
+	operationURL := url.URL{
+		Path: operationPath,
+	}
+
+	queryURL := serverURL.ResolveReference(&operationURL)
+
+	queryValues := queryURL.Query()
+
+	if queryFrag, err := runtime.StyleParamWithLocation("form", true, "index", runtime.ParamLocationQuery, params.Index); err != nil {
+		return nil, err
+	} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+		return nil, err
+	} else {
+		for k, v := range parsed {
+			for _, v2 := range v {
+				queryValues.Add(k, v2)
+			}
+		}
+	}
+
+	queryURL.RawQuery = queryValues.Encode()
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewDeckOddsRequest generates requests for DeckOdds
+func NewDeckOddsRequest(server string, params *DeckOddsParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/cards/odds")
+	if operationPath[0] == '/' {
+		operationPath = operationPath[1:]
+	}
+	operationURL := url.URL{
+		Path: operationPath,
+	}
+
+	queryURL := serverURL.ResolveReference(&operationURL)
+
+	queryValues := queryURL.Query()
+
+	if queryFrag, err := runtime.StyleParamWithLocation("form", true, "event", runtime.ParamLocationQuery, params.Event); err != nil {
+		return nil, err
+	} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+		return nil, err
+	} else {
+		for k, v := range parsed {
+			for _, v2 := range v {
+				queryValues.Add(k, v2)
+			}
+		}
+	}
+
+	if params.Draws != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "draws", runtime.ParamLocationQuery, *params.Draws); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	if params.AtLeast != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "at_least", runtime.ParamLocationQuery, *params.AtLeast); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	queryURL.RawQuery = queryValues.Encode()
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewDeckPeekRequest generates requests for DeckPeek
+func NewDeckPeekRequest(server string, params *DeckPeekParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/cards/peek")
+	if operationPath[0] == '/' {
+		operationPath = operationPath[1:]
+	}
+	operationURL := url.URL{
+		Path: operationPath,
+	}
+
+	queryURL := serverURL.ResolveReference(&operationURL)
+
+	queryValues := queryURL.Query()
+
+	if params.Count != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "count", runtime.ParamLocationQuery, *params.Count); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	queryURL.RawQuery = queryValues.Encode()
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewDeckReset2Request generates requests for DeckReset2
+func NewDeckReset2Request(server string, params *DeckReset2Params) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/cards/reset")
+	if operationPath[0] == '/' {
+		operationPath = operationPath[1:]
+	}
+	operationURL := url.URL{
+		Path: operationPath,
+	}
+
+	queryURL := serverURL.ResolveReference(&operationURL)
+
+	queryValues := queryURL.Query()
+
+	if params.Order != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "order", runtime.ParamLocationQuery, *params.Order); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	if params.Cards != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "cards", runtime.ParamLocationQuery, *params.Cards); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	if params.Composition != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "composition", runtime.ParamLocationQuery, *params.Composition); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	queryURL.RawQuery = queryValues.Encode()
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewDeckResetRequest generates requests for DeckReset
+func NewDeckResetRequest(server string, params *DeckResetParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/cards/reset")
+	if operationPath[0] == '/' {
+		operationPath = operationPath[1:]
+	}
+	operationURL := url.URL{
+		Path: operationPath,
+	}
+
+	queryURL := serverURL.ResolveReference(&operationURL)
+
+	queryValues := queryURL.Query()
+
+	if params.Order != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "order", runtime.ParamLocationQuery, *params.Order); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	if params.Cards != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "cards", runtime.ParamLocationQuery, *params.Cards); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	if params.Composition != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "composition", runtime.ParamLocationQuery, *params.Composition); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	queryURL.RawQuery = queryValues.Encode()
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewDeckReturnCard2Request generates requests for DeckReturnCard2
+func NewDeckReturnCard2Request(server string, params *DeckReturnCard2Params) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/cards/return")
+	if operationPath[0] == '/' {
+		operationPath = operationPath[1:]
+	}
+	operationURL := url.URL{
+		Path: operationPath,
+	}
+
+	queryURL := serverURL.ResolveReference(&operationURL)
+
+	queryValues := queryURL.Query()
+
+	if params.Card != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "card", runtime.ParamLocationQuery, *params.Card); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	queryURL.RawQuery = queryValues.Encode()
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewDeckReturnCardRequest calls the generic DeckReturnCard builder with application/json body
+func NewDeckReturnCardRequest(server string, body DeckReturnCardJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewDeckReturnCardRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewDeckReturnCardRequestWithBody generates requests for DeckReturnCard with any type of body
+func NewDeckReturnCardRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/cards/return")
+	if operationPath[0] == '/' {
+		operationPath = operationPath[1:]
+	}
+	operationURL := url.URL{
+		Path: operationPath,
+	}
+
+	queryURL := serverURL.ResolveReference(&operationURL)
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewDeckShuffle2Request generates requests for DeckShuffle2
+func NewDeckShuffle2Request(server string, params *DeckShuffle2Params) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/cards/shuffle")
+	if operationPath[0] == '/' {
+		operationPath = operationPath[1:]
+	}
+	operationURL := url.URL{
+		Path: operationPath,
+	}
+
+	queryURL := serverURL.ResolveReference(&operationURL)
+
+	queryValues := queryURL.Query()
+
+	if params.Method != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "method", runtime.ParamLocationQuery, *params.Method); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	if params.Times != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "times", runtime.ParamLocationQuery, *params.Times); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	queryURL.RawQuery = queryValues.Encode()
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewDeckShuffleRequest generates requests for DeckShuffle
+func NewDeckShuffleRequest(server string, params *DeckShuffleParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/cards/shuffle")
+	if operationPath[0] == '/' {
+		operationPath = operationPath[1:]
+	}
+	operationURL := url.URL{
+		Path: operationPath,
+	}
+
+	queryURL := serverURL.ResolveReference(&operationURL)
+
+	queryValues := queryURL.Query()
+
+	if params.Method != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "method", runtime.ParamLocationQuery, *params.Method); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	if params.Times != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "times", runtime.ParamLocationQuery, *params.Times); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	queryURL.RawQuery = queryValues.Encode()
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewDeckSortRequest generates requests for DeckSort
+func NewDeckSortRequest(server string, params *DeckSortParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/cards/sort")
+	if operationPath[0] == '/' {
+		operationPath = operationPath[1:]
+	}
+	operationURL := url.URL{
+		Path: operationPath,
+	}
+
+	queryURL := serverURL.ResolveReference(&operationURL)
+
+	queryValues := queryURL.Query()
+
+	if params.Order != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "order", runtime.ParamLocationQuery, *params.Order); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	queryURL.RawQuery = queryValues.Encode()
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewDeckStatsRequest generates requests for DeckStats
+func NewDeckStatsRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/cards/stats")
+	if operationPath[0] == '/' {
+		operationPath = operationPath[1:]
+	}
+	operationURL := url.URL{
+		Path: operationPath,
+	}
+
+	queryURL := serverURL.ResolveReference(&operationURL)
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGoFishEndRequest generates requests for GoFishEnd
+func NewGoFishEndRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/games/gofish")
+	if operationPath[0] == '/' {
+		operationPath = operationPath[1:]
+	}
+	operationURL := url.URL{
+		Path: operationPath,
+	}
+
+	queryURL := serverURL.ResolveReference(&operationURL)
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGoFishShowRequest generates requests for GoFishShow
+func NewGoFishShowRequest(server string, params *GoFishShowParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/games/gofish")
+	if operationPath[0] == '/' {
+		operationPath = operationPath[1:]
+	}
+	operationURL := url.URL{
+		Path: operationPath,
+	}
+
+	queryURL := serverURL.ResolveReference(&operationURL)
+
+	queryValues := queryURL.Query()
+
+	if params.Player != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "player", runtime.ParamLocationQuery, *params.Player); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	queryURL.RawQuery = queryValues.Encode()
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGoFishStartRequest calls the generic GoFishStart builder with application/json body
+func NewGoFishStartRequest(server string, body GoFishStartJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewGoFishStartRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewGoFishStartRequestWithBody generates requests for GoFishStart with any type of body
+func NewGoFishStartRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/games/gofish")
+	if operationPath[0] == '/' {
+		operationPath = operationPath[1:]
+	}
+	operationURL := url.URL{
+		Path: operationPath,
+	}
+
+	queryURL := serverURL.ResolveReference(&operationURL)
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGoFishAskRequest calls the generic GoFishAsk builder with application/json body
+func NewGoFishAskRequest(server string, body GoFishAskJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewGoFishAskRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewGoFishAskRequestWithBody generates requests for GoFishAsk with any type of body
+func NewGoFishAskRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/games/gofish/asks")
+	if operationPath[0] == '/' {
+		operationPath = operationPath[1:]
+	}
+	operationURL := url.URL{
+		Path: operationPath,
+	}
+
+	queryURL := serverURL.ResolveReference(&operationURL)
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewKlondikeEndRequest generates requests for KlondikeEnd
+func NewKlondikeEndRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/games/klondike")
+	if operationPath[0] == '/' {
+		operationPath = operationPath[1:]
+	}
+	operationURL := url.URL{
+		Path: operationPath,
+	}
+
+	queryURL := serverURL.ResolveReference(&operationURL)
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewKlondikeShowRequest generates requests for KlondikeShow
+func NewKlondikeShowRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/games/klondike")
+	if operationPath[0] == '/' {
+		operationPath = operationPath[1:]
+	}
+	operationURL := url.URL{
+		Path: operationPath,
+	}
+
+	queryURL := serverURL.ResolveReference(&operationURL)
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewKlondikeStartRequest generates requests for KlondikeStart
+func NewKlondikeStartRequest(server string, params *KlondikeStartParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/games/klondike")
+	if operationPath[0] == '/' {
+		operationPath = operationPath[1:]
+	}
+	operationURL := url.URL{
+		Path: operationPath,
+	}
+
+	queryURL := serverURL.ResolveReference(&operationURL)
+
+	queryValues := queryURL.Query()
+
+	if params.Draw != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "draw", runtime.ParamLocationQuery, *params.Draw); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	queryURL.RawQuery = queryValues.Encode()
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewKlondikeHintRequest generates requests for KlondikeHint
+func NewKlondikeHintRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/games/klondike/hint")
+	if operationPath[0] == '/' {
+		operationPath = operationPath[1:]
+	}
+	operationURL := url.URL{
+		Path: operationPath,
+	}
+
+	queryURL := serverURL.ResolveReference(&operationURL)
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewKlondikeMovesRequest generates requests for KlondikeMoves
+func NewKlondikeMovesRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/games/klondike/moves")
+	if operationPath[0] == '/' {
+		operationPath = operationPath[1:]
+	}
+	operationURL := url.URL{
+		Path: operationPath,
+	}
+
+	queryURL := serverURL.ResolveReference(&operationURL)
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewKlondikeMoveRequest calls the generic KlondikeMove builder with application/json body
+func NewKlondikeMoveRequest(server string, body KlondikeMoveJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewKlondikeMoveRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewKlondikeMoveRequestWithBody generates requests for KlondikeMove with any type of body
+func NewKlondikeMoveRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/games/klondike/moves")
+	if operationPath[0] == '/' {
+		operationPath = operationPath[1:]
+	}
+	operationURL := url.URL{
+		Path: operationPath,
+	}
+
+	queryURL := serverURL.ResolveReference(&operationURL)
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewWarEndRequest generates requests for WarEnd
+func NewWarEndRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/games/war")
+	if operationPath[0] == '/' {
+		operationPath = operationPath[1:]
+	}
+	operationURL := url.URL{
+		Path: operationPath,
+	}
+
+	queryURL := serverURL.ResolveReference(&operationURL)
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewWarShowRequest generates requests for WarShow
+func NewWarShowRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/games/war")
+	if operationPath[0] == '/' {
+		operationPath = operationPath[1:]
+	}
+	operationURL := url.URL{
+		Path: operationPath,
+	}
+
+	queryURL := serverURL.ResolveReference(&operationURL)
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewWarStartRequest generates requests for WarStart
+func NewWarStartRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/games/war")
+	if operationPath[0] == '/' {
+		operationPath = operationPath[1:]
+	}
+	operationURL := url.URL{
+		Path: operationPath,
+	}
+
+	queryURL := serverURL.ResolveReference(&operationURL)
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewWarPlayRequest generates requests for WarPlay
+func NewWarPlayRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/games/war/play")
+	if operationPath[0] == '/' {
+		operationPath = operationPath[1:]
+	}
+	operationURL := url.URL{
+		Path: operationPath,
+	}
+
+	queryURL := serverURL.ResolveReference(&operationURL)
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewWarStepRequest generates requests for WarStep
+func NewWarStepRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/games/war/step")
+	if operationPath[0] == '/' {
+		operationPath = operationPath[1:]
+	}
+	operationURL := url.URL{
+		Path: operationPath,
+	}
+
+	queryURL := serverURL.ResolveReference(&operationURL)
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewHealthzRequest generates requests for Healthz
+func NewHealthzRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/healthz")
+	if operationPath[0] == '/' {
+		operationPath = operationPath[1:]
+	}
+	operationURL := url.URL{
+		Path: operationPath,
+	}
+
+	queryURL := serverURL.ResolveReference(&operationURL)
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewReadyzRequest generates requests for Readyz
+func NewReadyzRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/readyz")
+	if operationPath[0] == '/' {
+		operationPath = operationPath[1:]
+	}
+	operationURL := url.URL{
+		Path: operationPath,
+	}
+
+	queryURL := serverURL.ResolveReference(&operationURL)
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewVersionRequest generates requests for Version
+func NewVersionRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/version")
+	if operationPath[0] == '/' {
+		operationPath = operationPath[1:]
+	}
+	operationURL := url.URL{
+		Path: operationPath,
+	}
+
+	queryURL := serverURL.ResolveReference(&operationURL)
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+func (c *Client) applyEditors(ctx context.Context, req *http.Request, additionalEditors []RequestEditorFn) error {
+	for _, r := range c.RequestEditors {
+		if err := r(ctx, req); err != nil {
+			return err
+		}
+	}
+	for _, r := range additionalEditors {
+		if err := r(ctx, req); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ClientWithResponses builds on ClientInterface to offer response payloads
+type ClientWithResponses struct {
+	ClientInterface
+}
+
+// NewClientWithResponses creates a new ClientWithResponses, which wraps
+// Client with return type handling
+func NewClientWithResponses(server string, opts ...ClientOption) (*ClientWithResponses, error) {
+	client, err := NewClient(server, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &ClientWithResponses{client}, nil
+}
+
+// WithBaseURL overrides the baseURL.
+func WithBaseURL(baseURL string) ClientOption {
+	return func(c *Client) error {
+		newBaseURL, err := url.Parse(baseURL)
+		if err != nil {
+			return err
+		}
+		c.Server = newBaseURL.String()
+		return nil
+	}
+}
+
+// ClientWithResponsesInterface is the interface specification for the client with responses above.
+type ClientWithResponsesInterface interface {
+	// Index request
+	IndexWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*IndexResponse, error)
+
+	// AdminListSessions request
+	AdminListSessionsWithResponse(ctx context.Context, params *AdminListSessionsParams, reqEditors ...RequestEditorFn) (*AdminListSessionsResponse, error)
+
+	// AdminDeleteSession request
+	AdminDeleteSessionWithResponse(ctx context.Context, id SessionId, reqEditors ...RequestEditorFn) (*AdminDeleteSessionResponse, error)
+
+	// AdminGetSession request
+	AdminGetSessionWithResponse(ctx context.Context, id SessionId, reqEditors ...RequestEditorFn) (*AdminGetSessionResponse, error)
+
+	// AdminResetSession request
+	AdminResetSessionWithResponse(ctx context.Context, id SessionId, reqEditors ...RequestEditorFn) (*AdminResetSessionResponse, error)
+
+	// BridgeBoards request
+	BridgeBoardsWithResponse(ctx context.Context, params *BridgeBoardsParams, reqEditors ...RequestEditorFn) (*BridgeBoardsResponse, error)
+
+	// BridgeBoardsPbn request
+	BridgeBoardsPbnWithResponse(ctx context.Context, params *BridgeBoardsPbnParams, reqEditors ...RequestEditorFn) (*BridgeBoardsPbnResponse, error)
+
+	// BridgeDeal request
+	BridgeDealWithResponse(ctx context.Context, params *BridgeDealParams, reqEditors ...RequestEditorFn) (*BridgeDealResponse, error)
+
+	// BridgeDealPbn request
+	BridgeDealPbnWithResponse(ctx context.Context, params *BridgeDealPbnParams, reqEditors ...RequestEditorFn) (*BridgeDealPbnResponse, error)
+
+	// BridgeImportPbn request  with any body
+	BridgeImportPbnWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*BridgeImportPbnResponse, error)
+
+	// CardRenderSvg request
+	CardRenderSvgWithResponse(ctx context.Context, card string, params *CardRenderSvgParams, reqEditors ...RequestEditorFn) (*CardRenderSvgResponse, error)
+
+	// DeckShow request
+	DeckShowWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*DeckShowResponse, error)
+
+	// DeckRenderSvg request
+	DeckRenderSvgWithResponse(ctx context.Context, params *DeckRenderSvgParams, reqEditors ...RequestEditorFn) (*DeckRenderSvgResponse, error)
+
+	// DeckCut request
+	DeckCutWithResponse(ctx context.Context, params *DeckCutParams, reqEditors ...RequestEditorFn) (*DeckCutResponse, error)
+
+	// DeckDealCard2 request
+	DeckDealCard2WithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*DeckDealCard2Response, error)
+
+	// DeckDealCard request
+	DeckDealCardWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*DeckDealCardResponse, error)
+
+	// DeckDealAt request
+	DeckDealAtWithResponse(ctx context.Context, params *DeckDealAtParams, reqEditors ...RequestEditorFn) (*DeckDealAtResponse, error)
+
+	// DeckDealBottom request
+	DeckDealBottomWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*DeckDealBottomResponse, error)
+
+	// DeckDrawCard request  with any body
+	DeckDrawCardWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*DeckDrawCardResponse, error)
+
+	DeckDrawCardWithResponse(ctx context.Context, body DeckDrawCardJSONRequestBody, reqEditors ...RequestEditorFn) (*DeckDrawCardResponse, error)
+
+	// DeckInsertCard request  with any body
+	DeckInsertCardWithBodyWithResponse(ctx context.Context, params *DeckInsertCardParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*DeckInsertCardResponse, error)
+
+	DeckInsertCardWithResponse(ctx context.Context, params *DeckInsertCardParams, body DeckInsertCardJSONRequestBody, reqEditors ...RequestEditorFn) (*DeckInsertCardResponse, error)
+
+	// DeckOdds request
+	DeckOddsWithResponse(ctx context.Context, params *DeckOddsParams, reqEditors ...RequestEditorFn) (*DeckOddsResponse, error)
+
+	// DeckPeek request
+	DeckPeekWithResponse(ctx context.Context, params *DeckPeekParams, reqEditors ...RequestEditorFn) (*DeckPeekResponse, error)
+
+	// DeckReset2 request
+	DeckReset2WithResponse(ctx context.Context, params *DeckReset2Params, reqEditors ...RequestEditorFn) (*DeckReset2Response, error)
+
+	// DeckReset request
+	DeckResetWithResponse(ctx context.Context, params *DeckResetParams, reqEditors ...RequestEditorFn) (*DeckResetResponse, error)
+
+	// DeckReturnCard2 request
+	DeckReturnCard2WithResponse(ctx context.Context, params *DeckReturnCard2Params, reqEditors ...RequestEditorFn) (*DeckReturnCard2Response, error)
+
+	// DeckReturnCard request  with any body
+	DeckReturnCardWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*DeckReturnCardResponse, error)
+
+	DeckReturnCardWithResponse(ctx context.Context, body DeckReturnCardJSONRequestBody, reqEditors ...RequestEditorFn) (*DeckReturnCardResponse, error)
+
+	// DeckShuffle2 request
+	DeckShuffle2WithResponse(ctx context.Context, params *DeckShuffle2Params, reqEditors ...RequestEditorFn) (*DeckShuffle2Response, error)
+
+	// DeckShuffle request
+	DeckShuffleWithResponse(ctx context.Context, params *DeckShuffleParams, reqEditors ...RequestEditorFn) (*DeckShuffleResponse, error)
+
+	// DeckSort request
+	DeckSortWithResponse(ctx context.Context, params *DeckSortParams, reqEditors ...RequestEditorFn) (*DeckSortResponse, error)
+
+	// DeckStats request
+	DeckStatsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*DeckStatsResponse, error)
+
+	// GoFishEnd request
+	GoFishEndWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GoFishEndResponse, error)
+
+	// GoFishShow request
+	GoFishShowWithResponse(ctx context.Context, params *GoFishShowParams, reqEditors ...RequestEditorFn) (*GoFishShowResponse, error)
+
+	// GoFishStart request  with any body
+	GoFishStartWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*GoFishStartResponse, error)
+
+	GoFishStartWithResponse(ctx context.Context, body GoFishStartJSONRequestBody, reqEditors ...RequestEditorFn) (*GoFishStartResponse, error)
+
+	// GoFishAsk request  with any body
+	GoFishAskWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*GoFishAskResponse, error)
+
+	GoFishAskWithResponse(ctx context.Context, body GoFishAskJSONRequestBody, reqEditors ...RequestEditorFn) (*GoFishAskResponse, error)
+
+	// KlondikeEnd request
+	KlondikeEndWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*KlondikeEndResponse, error)
+
+	// KlondikeShow request
+	KlondikeShowWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*KlondikeShowResponse, error)
+
+	// KlondikeStart request
+	KlondikeStartWithResponse(ctx context.Context, params *KlondikeStartParams, reqEditors ...RequestEditorFn) (*KlondikeStartResponse, error)
+
+	// KlondikeHint request
+	KlondikeHintWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*KlondikeHintResponse, error)
+
+	// KlondikeMoves request
+	KlondikeMovesWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*KlondikeMovesResponse, error)
+
+	// KlondikeMove request  with any body
+	KlondikeMoveWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*KlondikeMoveResponse, error)
+
+	KlondikeMoveWithResponse(ctx context.Context, body KlondikeMoveJSONRequestBody, reqEditors ...RequestEditorFn) (*KlondikeMoveResponse, error)
+
+	// WarEnd request
+	WarEndWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*WarEndResponse, error)
+
+	// WarShow request
+	WarShowWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*WarShowResponse, error)
+
+	// WarStart request
+	WarStartWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*WarStartResponse, error)
+
+	// WarPlay request
+	WarPlayWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*WarPlayResponse, error)
+
+	// WarStep request
+	WarStepWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*WarStepResponse, error)
+
+	// Healthz request
+	HealthzWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*HealthzResponse, error)
+
+	// Readyz request
+	ReadyzWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ReadyzResponse, error)
+
+	// Version request
+	VersionWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*VersionResponse, error)
+}
+
+type IndexResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r IndexResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r IndexResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type AdminListSessionsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *AdminSessionPage
+}
+
+// Status returns HTTPResponse.Status
+func (r AdminListSessionsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r AdminListSessionsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type AdminDeleteSessionResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r AdminDeleteSessionResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r AdminDeleteSessionResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type AdminGetSessionResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *AdminSession
+}
+
+// Status returns HTTPResponse.Status
+func (r AdminGetSessionResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r AdminGetSessionResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type AdminResetSessionResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *AdminSession
+}
+
+// Status returns HTTPResponse.Status
+func (r AdminResetSessionResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r AdminResetSessionResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type BridgeBoardsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]BridgeDeal
+}
+
+// Status returns HTTPResponse.Status
+func (r BridgeBoardsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r BridgeBoardsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type BridgeBoardsPbnResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r BridgeBoardsPbnResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r BridgeBoardsPbnResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type BridgeDealResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *BridgeDeal
+}
+
+// Status returns HTTPResponse.Status
+func (r BridgeDealResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r BridgeDealResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type BridgeDealPbnResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r BridgeDealPbnResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r BridgeDealPbnResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type BridgeImportPbnResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *BridgeDeal
+}
+
+// Status returns HTTPResponse.Status
+func (r BridgeImportPbnResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r BridgeImportPbnResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CardRenderSvgResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r CardRenderSvgResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CardRenderSvgResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeckShowResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]Card
+}
+
+// Status returns HTTPResponse.Status
+func (r DeckShowResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeckShowResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeckRenderSvgResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r DeckRenderSvgResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeckRenderSvgResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeckCutResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]Card
+}
+
+// Status returns HTTPResponse.Status
+func (r DeckCutResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeckCutResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeckDealCard2Response struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Card
+}
+
+// Status returns HTTPResponse.Status
+func (r DeckDealCard2Response) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeckDealCard2Response) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeckDealCardResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Card
+}
+
+// Status returns HTTPResponse.Status
+func (r DeckDealCardResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeckDealCardResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeckDealAtResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Card
+}
+
+// Status returns HTTPResponse.Status
+func (r DeckDealAtResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeckDealAtResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeckDealBottomResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Card
+}
+
+// Status returns HTTPResponse.Status
+func (r DeckDealBottomResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeckDealBottomResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeckDrawCardResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]Card
+}
+
+// Status returns HTTPResponse.Status
+func (r DeckDrawCardResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeckDrawCardResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeckInsertCardResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]Card
+}
+
+// Status returns HTTPResponse.Status
+func (r DeckInsertCardResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeckInsertCardResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeckOddsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *DeckOdds
+}
+
+// Status returns HTTPResponse.Status
+func (r DeckOddsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeckOddsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeckPeekResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]Card
+}
+
+// Status returns HTTPResponse.Status
+func (r DeckPeekResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeckPeekResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeckReset2Response struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]Card
+}
+
+// Status returns HTTPResponse.Status
+func (r DeckReset2Response) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeckReset2Response) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeckResetResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]Card
+}
+
+// Status returns HTTPResponse.Status
+func (r DeckResetResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeckResetResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeckReturnCard2Response struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]Card
+}
+
+// Status returns HTTPResponse.Status
+func (r DeckReturnCard2Response) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeckReturnCard2Response) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeckReturnCardResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]Card
+}
+
+// Status returns HTTPResponse.Status
+func (r DeckReturnCardResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeckReturnCardResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeckShuffle2Response struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]Card
+}
+
+// Status returns HTTPResponse.Status
+func (r DeckShuffle2Response) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeckShuffle2Response) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeckShuffleResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]Card
+}
+
+// Status returns HTTPResponse.Status
+func (r DeckShuffleResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeckShuffleResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeckSortResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]Card
+}
+
+// Status returns HTTPResponse.Status
+func (r DeckSortResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeckSortResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeckStatsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *DeckStats
+}
+
+// Status returns HTTPResponse.Status
+func (r DeckStatsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeckStatsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GoFishEndResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]Card
+}
+
+// Status returns HTTPResponse.Status
+func (r GoFishEndResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GoFishEndResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GoFishShowResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *GoFishGame
+}
+
+// Status returns HTTPResponse.Status
+func (r GoFishShowResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GoFishShowResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GoFishStartResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *GoFishGame
+}
+
+// Status returns HTTPResponse.Status
+func (r GoFishStartResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GoFishStartResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GoFishAskResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *GoFishGame
+}
+
+// Status returns HTTPResponse.Status
+func (r GoFishAskResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GoFishAskResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type KlondikeEndResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]Card
+}
+
+// Status returns HTTPResponse.Status
+func (r KlondikeEndResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r KlondikeEndResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type KlondikeShowResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *KlondikeGame
+}
+
+// Status returns HTTPResponse.Status
+func (r KlondikeShowResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r KlondikeShowResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type KlondikeStartResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *KlondikeGame
+}
+
+// Status returns HTTPResponse.Status
+func (r KlondikeStartResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r KlondikeStartResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type KlondikeHintResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *KlondikeHint
+}
+
+// Status returns HTTPResponse.Status
+func (r KlondikeHintResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r KlondikeHintResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type KlondikeMovesResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]KlondikeMove
+}
+
+// Status returns HTTPResponse.Status
+func (r KlondikeMovesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r KlondikeMovesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type KlondikeMoveResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *KlondikeGame
+}
+
+// Status returns HTTPResponse.Status
+func (r KlondikeMoveResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r KlondikeMoveResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type WarEndResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]Card
+}
+
+// Status returns HTTPResponse.Status
+func (r WarEndResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r WarEndResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type WarShowResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *WarGame
+}
+
+// Status returns HTTPResponse.Status
+func (r WarShowResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r WarShowResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type WarStartResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *WarGame
+}
+
+// Status returns HTTPResponse.Status
+func (r WarStartResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r WarStartResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type WarPlayResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *WarGame
+}
+
+// Status returns HTTPResponse.Status
+func (r WarPlayResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r WarPlayResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type WarStepResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *WarRound
+}
+
+// Status returns HTTPResponse.Status
+func (r WarStepResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r WarStepResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type HealthzResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Health
+}
+
+// Status returns HTTPResponse.Status
+func (r HealthzResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r HealthzResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ReadyzResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Health
+	JSON503      *Health
+}
+
+// Status returns HTTPResponse.Status
+func (r ReadyzResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ReadyzResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type VersionResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Version
+}
+
+// Status returns HTTPResponse.Status
+func (r VersionResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r VersionResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// IndexWithResponse request returning *IndexResponse
+func (c *ClientWithResponses) IndexWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*IndexResponse, error) {
+	rsp, err := c.Index(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseIndexResponse(rsp)
+}
+
+// AdminListSessionsWithResponse request returning *AdminListSessionsResponse
+func (c *ClientWithResponses) AdminListSessionsWithResponse(ctx context.Context, params *AdminListSessionsParams, reqEditors ...RequestEditorFn) (*AdminListSessionsResponse, error) {
+	rsp, err := c.AdminListSessions(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAdminListSessionsResponse(rsp)
+}
+
+// AdminDeleteSessionWithResponse request returning *AdminDeleteSessionResponse
+func (c *ClientWithResponses) AdminDeleteSessionWithResponse(ctx context.Context, id SessionId, reqEditors ...RequestEditorFn) (*AdminDeleteSessionResponse, error) {
+	rsp, err := c.AdminDeleteSession(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAdminDeleteSessionResponse(rsp)
+}
+
+// AdminGetSessionWithResponse request returning *AdminGetSessionResponse
+func (c *ClientWithResponses) AdminGetSessionWithResponse(ctx context.Context, id SessionId, reqEditors ...RequestEditorFn) (*AdminGetSessionResponse, error) {
+	rsp, err := c.AdminGetSession(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAdminGetSessionResponse(rsp)
+}
+
+// AdminResetSessionWithResponse request returning *AdminResetSessionResponse
+func (c *ClientWithResponses) AdminResetSessionWithResponse(ctx context.Context, id SessionId, reqEditors ...RequestEditorFn) (*AdminResetSessionResponse, error) {
+	rsp, err := c.AdminResetSession(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAdminResetSessionResponse(rsp)
+}
+
+// BridgeBoardsWithResponse request returning *BridgeBoardsResponse
+func (c *ClientWithResponses) BridgeBoardsWithResponse(ctx context.Context, params *BridgeBoardsParams, reqEditors ...RequestEditorFn) (*BridgeBoardsResponse, error) {
+	rsp, err := c.BridgeBoards(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseBridgeBoardsResponse(rsp)
+}
+
+// BridgeBoardsPbnWithResponse request returning *BridgeBoardsPbnResponse
+func (c *ClientWithResponses) BridgeBoardsPbnWithResponse(ctx context.Context, params *BridgeBoardsPbnParams, reqEditors ...RequestEditorFn) (*BridgeBoardsPbnResponse, error) {
+	rsp, err := c.BridgeBoardsPbn(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseBridgeBoardsPbnResponse(rsp)
+}
+
+// BridgeDealWithResponse request returning *BridgeDealResponse
+func (c *ClientWithResponses) BridgeDealWithResponse(ctx context.Context, params *BridgeDealParams, reqEditors ...RequestEditorFn) (*BridgeDealResponse, error) {
+	rsp, err := c.BridgeDeal(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseBridgeDealResponse(rsp)
+}
+
+// BridgeDealPbnWithResponse request returning *BridgeDealPbnResponse
+func (c *ClientWithResponses) BridgeDealPbnWithResponse(ctx context.Context, params *BridgeDealPbnParams, reqEditors ...RequestEditorFn) (*BridgeDealPbnResponse, error) {
+	rsp, err := c.BridgeDealPbn(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseBridgeDealPbnResponse(rsp)
+}
+
+// BridgeImportPbnWithBodyWithResponse request with arbitrary body returning *BridgeImportPbnResponse
+func (c *ClientWithResponses) BridgeImportPbnWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*BridgeImportPbnResponse, error) {
+	rsp, err := c.BridgeImportPbnWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseBridgeImportPbnResponse(rsp)
+}
+
+// CardRenderSvgWithResponse request returning *CardRenderSvgResponse
+func (c *ClientWithResponses) CardRenderSvgWithResponse(ctx context.Context, card string, params *CardRenderSvgParams, reqEditors ...RequestEditorFn) (*CardRenderSvgResponse, error) {
+	rsp, err := c.CardRenderSvg(ctx, card, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCardRenderSvgResponse(rsp)
+}
+
+// DeckShowWithResponse request returning *DeckShowResponse
+func (c *ClientWithResponses) DeckShowWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*DeckShowResponse, error) {
+	rsp, err := c.DeckShow(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeckShowResponse(rsp)
+}
+
+// DeckRenderSvgWithResponse request returning *DeckRenderSvgResponse
+func (c *ClientWithResponses) DeckRenderSvgWithResponse(ctx context.Context, params *DeckRenderSvgParams, reqEditors ...RequestEditorFn) (*DeckRenderSvgResponse, error) {
+	rsp, err := c.DeckRenderSvg(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeckRenderSvgResponse(rsp)
+}
+
+// DeckCutWithResponse request returning *DeckCutResponse
+func (c *ClientWithResponses) DeckCutWithResponse(ctx context.Context, params *DeckCutParams, reqEditors ...RequestEditorFn) (*DeckCutResponse, error) {
+	rsp, err := c.DeckCut(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeckCutResponse(rsp)
+}
+
+// DeckDealCard2WithResponse request returning *DeckDealCard2Response
+func (c *ClientWithResponses) DeckDealCard2WithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*DeckDealCard2Response, error) {
+	rsp, err := c.DeckDealCard2(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeckDealCard2Response(rsp)
+}
+
+// DeckDealCardWithResponse request returning *DeckDealCardResponse
+func (c *ClientWithResponses) DeckDealCardWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*DeckDealCardResponse, error) {
+	rsp, err := c.DeckDealCard(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeckDealCardResponse(rsp)
+}
+
+// DeckDealAtWithResponse request returning *DeckDealAtResponse
+func (c *ClientWithResponses) DeckDealAtWithResponse(ctx context.Context, params *DeckDealAtParams, reqEditors ...RequestEditorFn) (*DeckDealAtResponse, error) {
+	rsp, err := c.DeckDealAt(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeckDealAtResponse(rsp)
+}
+
+// DeckDealBottomWithResponse request returning *DeckDealBottomResponse
+func (c *ClientWithResponses) DeckDealBottomWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*DeckDealBottomResponse, error) {
+	rsp, err := c.DeckDealBottom(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeckDealBottomResponse(rsp)
+}
+
+// DeckDrawCardWithBodyWithResponse request with arbitrary body returning *DeckDrawCardResponse
+func (c *ClientWithResponses) DeckDrawCardWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*DeckDrawCardResponse, error) {
+	rsp, err := c.DeckDrawCardWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeckDrawCardResponse(rsp)
+}
+
+func (c *ClientWithResponses) DeckDrawCardWithResponse(ctx context.Context, body DeckDrawCardJSONRequestBody, reqEditors ...RequestEditorFn) (*DeckDrawCardResponse, error) {
+	rsp, err := c.DeckDrawCard(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeckDrawCardResponse(rsp)
+}
+
+// DeckInsertCardWithBodyWithResponse request with arbitrary body returning *DeckInsertCardResponse
+func (c *ClientWithResponses) DeckInsertCardWithBodyWithResponse(ctx context.Context, params *DeckInsertCardParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*DeckInsertCardResponse, error) {
+	rsp, err := c.DeckInsertCardWithBody(ctx, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeckInsertCardResponse(rsp)
+}
+
+func (c *ClientWithResponses) DeckInsertCardWithResponse(ctx context.Context, params *DeckInsertCardParams, body DeckInsertCardJSONRequestBody, reqEditors ...RequestEditorFn) (*DeckInsertCardResponse, error) {
+	rsp, err := c.DeckInsertCard(ctx, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeckInsertCardResponse(rsp)
+}
+
+// DeckOddsWithResponse request returning *DeckOddsResponse
+func (c *ClientWithResponses) DeckOddsWithResponse(ctx context.Context, params *DeckOddsParams, reqEditors ...RequestEditorFn) (*DeckOddsResponse, error) {
+	rsp, err := c.DeckOdds(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeckOddsResponse(rsp)
+}
+
+// DeckPeekWithResponse request returning *DeckPeekResponse
+func (c *ClientWithResponses) DeckPeekWithResponse(ctx context.Context, params *DeckPeekParams, reqEditors ...RequestEditorFn) (*DeckPeekResponse, error) {
+	rsp, err := c.DeckPeek(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeckPeekResponse(rsp)
+}
+
+// DeckReset2WithResponse request returning *DeckReset2Response
+func (c *ClientWithResponses) DeckReset2WithResponse(ctx context.Context, params *DeckReset2Params, reqEditors ...RequestEditorFn) (*DeckReset2Response, error) {
+	rsp, err := c.DeckReset2(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeckReset2Response(rsp)
+}
+
+// DeckResetWithResponse request returning *DeckResetResponse
+func (c *ClientWithResponses) DeckResetWithResponse(ctx context.Context, params *DeckResetParams, reqEditors ...RequestEditorFn) (*DeckResetResponse, error) {
+	rsp, err := c.DeckReset(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeckResetResponse(rsp)
+}
+
+// DeckReturnCard2WithResponse request returning *DeckReturnCard2Response
+func (c *ClientWithResponses) DeckReturnCard2WithResponse(ctx context.Context, params *DeckReturnCard2Params, reqEditors ...RequestEditorFn) (*DeckReturnCard2Response, error) {
+	rsp, err := c.DeckReturnCard2(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeckReturnCard2Response(rsp)
+}
+
+// DeckReturnCardWithBodyWithResponse request with arbitrary body returning *DeckReturnCardResponse
+func (c *ClientWithResponses) DeckReturnCardWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*DeckReturnCardResponse, error) {
+	rsp, err := c.DeckReturnCardWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeckReturnCardResponse(rsp)
+}
+
+func (c *ClientWithResponses) DeckReturnCardWithResponse(ctx context.Context, body DeckReturnCardJSONRequestBody, reqEditors ...RequestEditorFn) (*DeckReturnCardResponse, error) {
+	rsp, err := c.DeckReturnCard(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeckReturnCardResponse(rsp)
+}
+
+// DeckShuffle2WithResponse request returning *DeckShuffle2Response
+func (c *ClientWithResponses) DeckShuffle2WithResponse(ctx context.Context, params *DeckShuffle2Params, reqEditors ...RequestEditorFn) (*DeckShuffle2Response, error) {
+	rsp, err := c.DeckShuffle2(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeckShuffle2Response(rsp)
+}
+
+// DeckShuffleWithResponse request returning *DeckShuffleResponse
+func (c *ClientWithResponses) DeckShuffleWithResponse(ctx context.Context, params *DeckShuffleParams, reqEditors ...RequestEditorFn) (*DeckShuffleResponse, error) {
+	rsp, err := c.DeckShuffle(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeckShuffleResponse(rsp)
+}
+
+// DeckSortWithResponse request returning *DeckSortResponse
+func (c *ClientWithResponses) DeckSortWithResponse(ctx context.Context, params *DeckSortParams, reqEditors ...RequestEditorFn) (*DeckSortResponse, error) {
+	rsp, err := c.DeckSort(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeckSortResponse(rsp)
+}
+
+// DeckStatsWithResponse request returning *DeckStatsResponse
+func (c *ClientWithResponses) DeckStatsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*DeckStatsResponse, error) {
+	rsp, err := c.DeckStats(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeckStatsResponse(rsp)
+}
+
+// GoFishEndWithResponse request returning *GoFishEndResponse
+func (c *ClientWithResponses) GoFishEndWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GoFishEndResponse, error) {
+	rsp, err := c.GoFishEnd(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGoFishEndResponse(rsp)
+}
+
+// GoFishShowWithResponse request returning *GoFishShowResponse
+func (c *ClientWithResponses) GoFishShowWithResponse(ctx context.Context, params *GoFishShowParams, reqEditors ...RequestEditorFn) (*GoFishShowResponse, error) {
+	rsp, err := c.GoFishShow(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGoFishShowResponse(rsp)
+}
+
+// GoFishStartWithBodyWithResponse request with arbitrary body returning *GoFishStartResponse
+func (c *ClientWithResponses) GoFishStartWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*GoFishStartResponse, error) {
+	rsp, err := c.GoFishStartWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGoFishStartResponse(rsp)
+}
+
+func (c *ClientWithResponses) GoFishStartWithResponse(ctx context.Context, body GoFishStartJSONRequestBody, reqEditors ...RequestEditorFn) (*GoFishStartResponse, error) {
+	rsp, err := c.GoFishStart(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGoFishStartResponse(rsp)
+}
+
+// GoFishAskWithBodyWithResponse request with arbitrary body returning *GoFishAskResponse
+func (c *ClientWithResponses) GoFishAskWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*GoFishAskResponse, error) {
+	rsp, err := c.GoFishAskWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGoFishAskResponse(rsp)
+}
+
+func (c *ClientWithResponses) GoFishAskWithResponse(ctx context.Context, body GoFishAskJSONRequestBody, reqEditors ...RequestEditorFn) (*GoFishAskResponse, error) {
+	rsp, err := c.GoFishAsk(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGoFishAskResponse(rsp)
+}
+
+// KlondikeEndWithResponse request returning *KlondikeEndResponse
+func (c *ClientWithResponses) KlondikeEndWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*KlondikeEndResponse, error) {
+	rsp, err := c.KlondikeEnd(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseKlondikeEndResponse(rsp)
+}
+
+// KlondikeShowWithResponse request returning *KlondikeShowResponse
+func (c *ClientWithResponses) KlondikeShowWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*KlondikeShowResponse, error) {
+	rsp, err := c.KlondikeShow(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseKlondikeShowResponse(rsp)
+}
+
+// KlondikeStartWithResponse request returning *KlondikeStartResponse
+func (c *ClientWithResponses) KlondikeStartWithResponse(ctx context.Context, params *KlondikeStartParams, reqEditors ...RequestEditorFn) (*KlondikeStartResponse, error) {
+	rsp, err := c.KlondikeStart(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseKlondikeStartResponse(rsp)
+}
+
+// KlondikeHintWithResponse request returning *KlondikeHintResponse
+func (c *ClientWithResponses) KlondikeHintWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*KlondikeHintResponse, error) {
+	rsp, err := c.KlondikeHint(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseKlondikeHintResponse(rsp)
+}
+
+// KlondikeMovesWithResponse request returning *KlondikeMovesResponse
+func (c *ClientWithResponses) KlondikeMovesWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*KlondikeMovesResponse, error) {
+	rsp, err := c.KlondikeMoves(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseKlondikeMovesResponse(rsp)
+}
+
+// KlondikeMoveWithBodyWithResponse request with arbitrary body returning *KlondikeMoveResponse
+func (c *ClientWithResponses) KlondikeMoveWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*KlondikeMoveResponse, error) {
+	rsp, err := c.KlondikeMoveWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseKlondikeMoveResponse(rsp)
+}
+
+func (c *ClientWithResponses) KlondikeMoveWithResponse(ctx context.Context, body KlondikeMoveJSONRequestBody, reqEditors ...RequestEditorFn) (*KlondikeMoveResponse, error) {
+	rsp, err := c.KlondikeMove(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseKlondikeMoveResponse(rsp)
+}
+
+// WarEndWithResponse request returning *WarEndResponse
+func (c *ClientWithResponses) WarEndWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*WarEndResponse, error) {
+	rsp, err := c.WarEnd(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseWarEndResponse(rsp)
+}
+
+// WarShowWithResponse request returning *WarShowResponse
+func (c *ClientWithResponses) WarShowWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*WarShowResponse, error) {
+	rsp, err := c.WarShow(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseWarShowResponse(rsp)
+}
+
+// WarStartWithResponse request returning *WarStartResponse
+func (c *ClientWithResponses) WarStartWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*WarStartResponse, error) {
+	rsp, err := c.WarStart(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseWarStartResponse(rsp)
+}
+
+// WarPlayWithResponse request returning *WarPlayResponse
+func (c *ClientWithResponses) WarPlayWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*WarPlayResponse, error) {
+	rsp, err := c.WarPlay(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseWarPlayResponse(rsp)
+}
+
+// WarStepWithResponse request returning *WarStepResponse
+func (c *ClientWithResponses) WarStepWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*WarStepResponse, error) {
+	rsp, err := c.WarStep(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseWarStepResponse(rsp)
+}
+
+// HealthzWithResponse request returning *HealthzResponse
+func (c *ClientWithResponses) HealthzWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*HealthzResponse, error) {
+	rsp, err := c.Healthz(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseHealthzResponse(rsp)
+}
+
+// ReadyzWithResponse request returning *ReadyzResponse
+func (c *ClientWithResponses) ReadyzWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ReadyzResponse, error) {
+	rsp, err := c.Readyz(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseReadyzResponse(rsp)
+}
+
+// VersionWithResponse request returning *VersionResponse
+func (c *ClientWithResponses) VersionWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*VersionResponse, error) {
+	rsp, err := c.Version(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseVersionResponse(rsp)
+}
+
+// ParseIndexResponse parses an HTTP response from a IndexWithResponse call
+func ParseIndexResponse(rsp *http.Response) (*IndexResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer rsp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &IndexResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	}
+
+	return response, nil
+}
+
+// ParseAdminListSessionsResponse parses an HTTP response from a AdminListSessionsWithResponse call
+func ParseAdminListSessionsResponse(rsp *http.Response) (*AdminListSessionsResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer rsp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &AdminListSessionsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest AdminSessionPage
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseAdminDeleteSessionResponse parses an HTTP response from a AdminDeleteSessionWithResponse call
+func ParseAdminDeleteSessionResponse(rsp *http.Response) (*AdminDeleteSessionResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer rsp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &AdminDeleteSessionResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	}
+
+	return response, nil
+}
+
+// ParseAdminGetSessionResponse parses an HTTP response from a AdminGetSessionWithResponse call
+func ParseAdminGetSessionResponse(rsp *http.Response) (*AdminGetSessionResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer rsp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &AdminGetSessionResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest AdminSession
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseAdminResetSessionResponse parses an HTTP response from a AdminResetSessionWithResponse call
+func ParseAdminResetSessionResponse(rsp *http.Response) (*AdminResetSessionResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer rsp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &AdminResetSessionResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest AdminSession
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseBridgeBoardsResponse parses an HTTP response from a BridgeBoardsWithResponse call
+func ParseBridgeBoardsResponse(rsp *http.Response) (*BridgeBoardsResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer rsp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &BridgeBoardsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []BridgeDeal
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseBridgeBoardsPbnResponse parses an HTTP response from a BridgeBoardsPbnWithResponse call
+func ParseBridgeBoardsPbnResponse(rsp *http.Response) (*BridgeBoardsPbnResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer rsp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &BridgeBoardsPbnResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	}
+
+	return response, nil
+}
+
+// ParseBridgeDealResponse parses an HTTP response from a BridgeDealWithResponse call
+func ParseBridgeDealResponse(rsp *http.Response) (*BridgeDealResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer rsp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &BridgeDealResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest BridgeDeal
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseBridgeDealPbnResponse parses an HTTP response from a BridgeDealPbnWithResponse call
+func ParseBridgeDealPbnResponse(rsp *http.Response) (*BridgeDealPbnResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer rsp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &BridgeDealPbnResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	}
+
+	return response, nil
+}
+
+// ParseBridgeImportPbnResponse parses an HTTP response from a BridgeImportPbnWithResponse call
+func ParseBridgeImportPbnResponse(rsp *http.Response) (*BridgeImportPbnResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer rsp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &BridgeImportPbnResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest BridgeDeal
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseCardRenderSvgResponse parses an HTTP response from a CardRenderSvgWithResponse call
+func ParseCardRenderSvgResponse(rsp *http.Response) (*CardRenderSvgResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer rsp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &CardRenderSvgResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	}
+
+	return response, nil
+}
+
+// ParseDeckShowResponse parses an HTTP response from a DeckShowWithResponse call
+func ParseDeckShowResponse(rsp *http.Response) (*DeckShowResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer rsp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeckShowResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []Card
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case rsp.StatusCode == 200:
+		// Content-type (text/plain) unsupported
+
+	}
+
+	return response, nil
+}
+
+// ParseDeckRenderSvgResponse parses an HTTP response from a DeckRenderSvgWithResponse call
+func ParseDeckRenderSvgResponse(rsp *http.Response) (*DeckRenderSvgResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer rsp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeckRenderSvgResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	}
+
+	return response, nil
+}
+
+// ParseDeckCutResponse parses an HTTP response from a DeckCutWithResponse call
+func ParseDeckCutResponse(rsp *http.Response) (*DeckCutResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer rsp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeckCutResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []Card
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case rsp.StatusCode == 200:
+		// Content-type (text/plain) unsupported
+
+	}
+
+	return response, nil
+}
+
+// ParseDeckDealCard2Response parses an HTTP response from a DeckDealCard2WithResponse call
+func ParseDeckDealCard2Response(rsp *http.Response) (*DeckDealCard2Response, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer rsp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeckDealCard2Response{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Card
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case rsp.StatusCode == 200:
+		// Content-type (text/plain) unsupported
+
+	}
+
+	return response, nil
+}
+
+// ParseDeckDealCardResponse parses an HTTP response from a DeckDealCardWithResponse call
+func ParseDeckDealCardResponse(rsp *http.Response) (*DeckDealCardResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer rsp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeckDealCardResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Card
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case rsp.StatusCode == 200:
+		// Content-type (text/plain) unsupported
+
+	}
+
+	return response, nil
+}
+
+// ParseDeckDealAtResponse parses an HTTP response from a DeckDealAtWithResponse call
+func ParseDeckDealAtResponse(rsp *http.Response) (*DeckDealAtResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer rsp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeckDealAtResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Card
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case rsp.StatusCode == 200:
+		// Content-type (text/plain) unsupported
+
+	}
+
+	return response, nil
+}
+
+// ParseDeckDealBottomResponse parses an HTTP response from a DeckDealBottomWithResponse call
+func ParseDeckDealBottomResponse(rsp *http.Response) (*DeckDealBottomResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer rsp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeckDealBottomResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Card
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case rsp.StatusCode == 200:
+		// Content-type (text/plain) unsupported
+
+	}
+
+	return response, nil
+}
+
+// ParseDeckDrawCardResponse parses an HTTP response from a DeckDrawCardWithResponse call
+func ParseDeckDrawCardResponse(rsp *http.Response) (*DeckDrawCardResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer rsp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeckDrawCardResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []Card
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case rsp.StatusCode == 200:
+		// Content-type (text/plain) unsupported
+
+	}
+
+	return response, nil
+}
+
+// ParseDeckInsertCardResponse parses an HTTP response from a DeckInsertCardWithResponse call
+func ParseDeckInsertCardResponse(rsp *http.Response) (*DeckInsertCardResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer rsp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeckInsertCardResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []Card
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case rsp.StatusCode == 200:
+		// Content-type (text/plain) unsupported
+
+	}
+
+	return response, nil
+}
+
+// ParseDeckOddsResponse parses an HTTP response from a DeckOddsWithResponse call
+func ParseDeckOddsResponse(rsp *http.Response) (*DeckOddsResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer rsp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeckOddsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest DeckOdds
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDeckPeekResponse parses an HTTP response from a DeckPeekWithResponse call
+func ParseDeckPeekResponse(rsp *http.Response) (*DeckPeekResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer rsp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeckPeekResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []Card
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case rsp.StatusCode == 200:
+		// Content-type (text/plain) unsupported
+
+	}
+
+	return response, nil
+}
+
+// ParseDeckReset2Response parses an HTTP response from a DeckReset2WithResponse call
+func ParseDeckReset2Response(rsp *http.Response) (*DeckReset2Response, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer rsp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeckReset2Response{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []Card
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case rsp.StatusCode == 200:
+		// Content-type (text/plain) unsupported
+
+	}
+
+	return response, nil
+}
+
+// ParseDeckResetResponse parses an HTTP response from a DeckResetWithResponse call
+func ParseDeckResetResponse(rsp *http.Response) (*DeckResetResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer rsp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeckResetResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []Card
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case rsp.StatusCode == 200:
+		// Content-type (text/plain) unsupported
+
+	}
+
+	return response, nil
+}
+
+// ParseDeckReturnCard2Response parses an HTTP response from a DeckReturnCard2WithResponse call
+func ParseDeckReturnCard2Response(rsp *http.Response) (*DeckReturnCard2Response, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer rsp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeckReturnCard2Response{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []Card
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case rsp.StatusCode == 200:
+		// Content-type (text/plain) unsupported
+
+	}
+
+	return response, nil
+}
+
+// ParseDeckReturnCardResponse parses an HTTP response from a DeckReturnCardWithResponse call
+func ParseDeckReturnCardResponse(rsp *http.Response) (*DeckReturnCardResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer rsp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeckReturnCardResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []Card
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case rsp.StatusCode == 200:
+		// Content-type (text/plain) unsupported
+
+	}
+
+	return response, nil
+}
+
+// ParseDeckShuffle2Response parses an HTTP response from a DeckShuffle2WithResponse call
+func ParseDeckShuffle2Response(rsp *http.Response) (*DeckShuffle2Response, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer rsp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeckShuffle2Response{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []Card
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case rsp.StatusCode == 200:
+		// Content-type (text/plain) unsupported
+
+	}
+
+	return response, nil
+}
+
+// ParseDeckShuffleResponse parses an HTTP response from a DeckShuffleWithResponse call
+func ParseDeckShuffleResponse(rsp *http.Response) (*DeckShuffleResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer rsp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeckShuffleResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []Card
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case rsp.StatusCode == 200:
+		// Content-type (text/plain) unsupported
+
+	}
+
+	return response, nil
+}
+
+// ParseDeckSortResponse parses an HTTP response from a DeckSortWithResponse call
+func ParseDeckSortResponse(rsp *http.Response) (*DeckSortResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer rsp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeckSortResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []Card
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case rsp.StatusCode == 200:
+		// Content-type (text/plain) unsupported
+
+	}
+
+	return response, nil
+}
+
+// ParseDeckStatsResponse parses an HTTP response from a DeckStatsWithResponse call
+func ParseDeckStatsResponse(rsp *http.Response) (*DeckStatsResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer rsp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeckStatsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest DeckStats
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGoFishEndResponse parses an HTTP response from a GoFishEndWithResponse call
+func ParseGoFishEndResponse(rsp *http.Response) (*GoFishEndResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer rsp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GoFishEndResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []Card
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case rsp.StatusCode == 200:
+		// Content-type (text/plain) unsupported
+
+	}
+
+	return response, nil
+}
+
+// ParseGoFishShowResponse parses an HTTP response from a GoFishShowWithResponse call
+func ParseGoFishShowResponse(rsp *http.Response) (*GoFishShowResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer rsp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GoFishShowResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest GoFishGame
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGoFishStartResponse parses an HTTP response from a GoFishStartWithResponse call
+func ParseGoFishStartResponse(rsp *http.Response) (*GoFishStartResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer rsp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GoFishStartResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest GoFishGame
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGoFishAskResponse parses an HTTP response from a GoFishAskWithResponse call
+func ParseGoFishAskResponse(rsp *http.Response) (*GoFishAskResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer rsp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GoFishAskResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest GoFishGame
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseKlondikeEndResponse parses an HTTP response from a KlondikeEndWithResponse call
+func ParseKlondikeEndResponse(rsp *http.Response) (*KlondikeEndResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer rsp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &KlondikeEndResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []Card
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case rsp.StatusCode == 200:
+		// Content-type (text/plain) unsupported
+
+	}
+
+	return response, nil
+}
+
+// ParseKlondikeShowResponse parses an HTTP response from a KlondikeShowWithResponse call
+func ParseKlondikeShowResponse(rsp *http.Response) (*KlondikeShowResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer rsp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &KlondikeShowResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest KlondikeGame
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseKlondikeStartResponse parses an HTTP response from a KlondikeStartWithResponse call
+func ParseKlondikeStartResponse(rsp *http.Response) (*KlondikeStartResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer rsp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &KlondikeStartResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest KlondikeGame
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseKlondikeHintResponse parses an HTTP response from a KlondikeHintWithResponse call
+func ParseKlondikeHintResponse(rsp *http.Response) (*KlondikeHintResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer rsp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &KlondikeHintResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest KlondikeHint
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseKlondikeMovesResponse parses an HTTP response from a KlondikeMovesWithResponse call
+func ParseKlondikeMovesResponse(rsp *http.Response) (*KlondikeMovesResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer rsp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &KlondikeMovesResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []KlondikeMove
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseKlondikeMoveResponse parses an HTTP response from a KlondikeMoveWithResponse call
+func ParseKlondikeMoveResponse(rsp *http.Response) (*KlondikeMoveResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer rsp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &KlondikeMoveResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest KlondikeGame
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseWarEndResponse parses an HTTP response from a WarEndWithResponse call
+func ParseWarEndResponse(rsp *http.Response) (*WarEndResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer rsp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &WarEndResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []Card
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case rsp.StatusCode == 200:
+		// Content-type (text/plain) unsupported
+
+	}
+
+	return response, nil
+}
+
+// ParseWarShowResponse parses an HTTP response from a WarShowWithResponse call
+func ParseWarShowResponse(rsp *http.Response) (*WarShowResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer rsp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &WarShowResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest WarGame
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseWarStartResponse parses an HTTP response from a WarStartWithResponse call
+func ParseWarStartResponse(rsp *http.Response) (*WarStartResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer rsp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &WarStartResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest WarGame
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseWarPlayResponse parses an HTTP response from a WarPlayWithResponse call
+func ParseWarPlayResponse(rsp *http.Response) (*WarPlayResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer rsp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &WarPlayResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest WarGame
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseWarStepResponse parses an HTTP response from a WarStepWithResponse call
+func ParseWarStepResponse(rsp *http.Response) (*WarStepResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer rsp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &WarStepResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest WarRound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseHealthzResponse parses an HTTP response from a HealthzWithResponse call
+func ParseHealthzResponse(rsp *http.Response) (*HealthzResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer rsp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &HealthzResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Health
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseReadyzResponse parses an HTTP response from a ReadyzWithResponse call
+func ParseReadyzResponse(rsp *http.Response) (*ReadyzResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer rsp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ReadyzResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Health
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 503:
+		var dest Health
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON503 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseVersionResponse parses an HTTP response from a VersionWithResponse call
+func ParseVersionResponse(rsp *http.Response) (*VersionResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer rsp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &VersionResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Version
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
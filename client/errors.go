@@ -0,0 +1,102 @@
+package client
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"mime"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// Sentinel errors that the problems returned by the server can be matched against with errors.Is
+var (
+	ErrDeckEmpty       = errors.New("the deck is empty")
+	ErrDeckFull        = errors.New("the deck is full")
+	ErrCardDuplicate   = errors.New("the card already exists in the deck")
+	ErrCardUnparseable = errors.New("the card could not be parsed")
+	ErrCardForeign     = errors.New("the card is not a part of the deck's composition")
+	ErrOrderInvalid    = errors.New("the order is not an arrangement of the deck's cards")
+	ErrCardMissing     = errors.New("the card is not in the deck")
+	ErrIndexOutOfRange = errors.New("the index is out of the deck's range")
+	ErrDeckShort       = errors.New("the deck has fewer cards than are needed")
+	ErrGameInProgress  = errors.New("a game is being played with the deck's cards")
+	ErrGameNotFound    = errors.New("there is no such game being played in the session")
+	ErrGameOver        = errors.New("the game is over")
+	ErrMoveIllegal     = errors.New("the move is not allowed")
+	ErrPlayerNotFound  = errors.New("there is no such player in the game")
+	ErrRequestInvalid  = errors.New("the request is invalid")
+	ErrRateLimited     = errors.New("the rate limit is exceeded")
+)
+
+var problemErrors = map[ProblemCode]error{
+	ProblemCodeDeckEmpty:       ErrDeckEmpty,
+	ProblemCodeDeckFull:        ErrDeckFull,
+	ProblemCodeCardDuplicate:   ErrCardDuplicate,
+	ProblemCodeCardUnparseable: ErrCardUnparseable,
+	ProblemCodeCardForeign:     ErrCardForeign,
+	ProblemCodeOrderInvalid:    ErrOrderInvalid,
+	ProblemCodeCardMissing:     ErrCardMissing,
+	ProblemCodeIndexOutOfRange: ErrIndexOutOfRange,
+	ProblemCodeDeckShort:       ErrDeckShort,
+	ProblemCodeGameInProgress:  ErrGameInProgress,
+	ProblemCodeGameNotFound:    ErrGameNotFound,
+	ProblemCodeGameOver:        ErrGameOver,
+	ProblemCodeMoveIllegal:     ErrMoveIllegal,
+	ProblemCodePlayerNotFound:  ErrPlayerNotFound,
+	ProblemCodeRequestInvalid:  ErrRequestInvalid,
+	ProblemCodeRateLimited:     ErrRateLimited,
+}
+
+// ProblemError is returned for all the non-successful responses
+type ProblemError struct {
+	Problem
+
+	// RetryAfter is how long the server asked to wait before retrying (the rate limited requests only)
+	RetryAfter time.Duration
+}
+
+func (e *ProblemError) Error() string {
+	if e.Code == "" {
+		return fmt.Sprintf("unexpected response %d: %s", e.Status, e.Detail)
+	}
+
+	return fmt.Sprintf("%s (%s): %s", e.Title, e.Code, e.Detail)
+}
+
+// Is makes errors.Is(err, ErrDeckEmpty) and the like succeed
+func (e *ProblemError) Is(target error) bool {
+	sentinel, ok := problemErrors[e.Code]
+	return ok && sentinel == target
+}
+
+// problemFromResponse parses the 'application/problem+json' body; other bodies are reported as is
+func problemFromResponse(rsp *http.Response, body []byte) error {
+	mediaType, _, _ := mime.ParseMediaType(rsp.Header.Get("Content-Type"))
+
+	if mediaType == "application/problem+json" {
+		var p Problem
+		if err := json.Unmarshal(body, &p); err == nil {
+			return &ProblemError{Problem: p, RetryAfter: retryAfter(rsp)}
+		}
+	}
+
+	return &ProblemError{
+		Problem: Problem{
+			Status: rsp.StatusCode,
+			Title:  http.StatusText(rsp.StatusCode),
+			Detail: string(body),
+		},
+	}
+}
+
+// retryAfter parses the delay-seconds form of the Retry-After header
+func retryAfter(rsp *http.Response) time.Duration {
+	seconds, err := strconv.Atoi(rsp.Header.Get("Retry-After"))
+	if err != nil || seconds < 0 {
+		return 0
+	}
+
+	return time.Duration(seconds) * time.Second
+}
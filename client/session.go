@@ -0,0 +1,250 @@
+package client
+
+import (
+	"context"
+	"net/http"
+	"sync"
+)
+
+// The names of the cookie & the header that carry the session id
+const (
+	SessionCookie = "session"
+	SessionHeader = "X-Session-Id"
+)
+
+// SessionTransport selects how the session id is sent to the server
+type SessionTransport uint8
+
+// SessionTransport values
+const (
+	SessionTransportCookie SessionTransport = iota // the "session" cookie, like a browser would
+	SessionTransportHeader                         // the "X-Session-Id" header
+)
+
+// Session is a typed client bound to a single deck on the server. The session id
+// is assigned by the server on the first request, unless one is given up front.
+type Session struct {
+	api       *ClientWithResponses
+	transport SessionTransport
+
+	lock sync.Mutex
+	id   string
+}
+
+// SessionOption allows setting custom parameters during construction
+type SessionOption func(*sessionConfig)
+
+type sessionConfig struct {
+	id        string
+	transport SessionTransport
+	opts      []ClientOption
+}
+
+// WithSessionID resumes an existing session
+func WithSessionID(id string) SessionOption {
+	return func(c *sessionConfig) {
+		c.id = id
+	}
+}
+
+// WithSessionTransport selects how the session id is sent to the server (cookie by default)
+func WithSessionTransport(transport SessionTransport) SessionOption {
+	return func(c *sessionConfig) {
+		c.transport = transport
+	}
+}
+
+// WithClientOptions passes the options through to the generated client
+func WithClientOptions(opts ...ClientOption) SessionOption {
+	return func(c *sessionConfig) {
+		c.opts = append(c.opts, opts...)
+	}
+}
+
+// NewSession creates a client for the server at the given base url (e.g. "http://localhost:8080")
+func NewSession(server string, opts ...SessionOption) (*Session, error) {
+	var config sessionConfig
+
+	for _, o := range opts {
+		o(&config)
+	}
+
+	s := &Session{
+		transport: config.transport,
+		id:        config.id,
+	}
+
+	// the session doer wraps whichever doer the other options have configured
+	wrap := func(c *Client) error {
+		if c.Client == nil {
+			c.Client = &http.Client{}
+		}
+
+		c.Client = &sessionDoer{session: s, next: c.Client}
+
+		return nil
+	}
+
+	api, err := NewClientWithResponses(server, append(config.opts, wrap)...)
+	if err != nil {
+		return nil, err
+	}
+
+	s.api = api
+
+	return s, nil
+}
+
+// ID returns the session id, which is empty until the first response is received
+func (s *Session) ID() string {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	return s.id
+}
+
+// API exposes the generated client (bound to this session) for the less common operations
+func (s *Session) API() *ClientWithResponses {
+	return s.api
+}
+
+// Show returns the current state of the deck
+func (s *Session) Show(ctx context.Context) ([]Card, error) {
+	rsp, err := s.api.DeckShowWithResponse(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if rsp.JSON200 == nil {
+		return nil, problemFromResponse(rsp.HTTPResponse, rsp.Body)
+	}
+
+	return *rsp.JSON200, nil
+}
+
+// Shuffle permutes the deck & returns its new state
+func (s *Session) Shuffle(ctx context.Context) ([]Card, error) {
+	return s.ShuffleWith(ctx, nil)
+}
+
+// ShuffleWith permutes the deck with the given method (e.g. a riffle shuffle repeated 7 times) & returns its new state
+func (s *Session) ShuffleWith(ctx context.Context, params *DeckShuffleParams) ([]Card, error) {
+	// the generated client does not accept nil params
+	if params == nil {
+		params = &DeckShuffleParams{}
+	}
+
+	rsp, err := s.api.DeckShuffleWithResponse(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+
+	if rsp.JSON200 == nil {
+		return nil, problemFromResponse(rsp.HTTPResponse, rsp.Body)
+	}
+
+	return *rsp.JSON200, nil
+}
+
+// Sort sorts the deck in the given order (the new deck order by default) & returns its new state
+func (s *Session) Sort(ctx context.Context, params *DeckSortParams) ([]Card, error) {
+	// the generated client does not accept nil params
+	if params == nil {
+		params = &DeckSortParams{}
+	}
+
+	rsp, err := s.api.DeckSortWithResponse(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+
+	if rsp.JSON200 == nil {
+		return nil, problemFromResponse(rsp.HTTPResponse, rsp.Body)
+	}
+
+	return *rsp.JSON200, nil
+}
+
+// Deal removes the top card from the deck & returns it
+func (s *Session) Deal(ctx context.Context) (Card, error) {
+	rsp, err := s.api.DeckDealCardWithResponse(ctx)
+	if err != nil {
+		return Card{}, err
+	}
+
+	if rsp.JSON200 == nil {
+		return Card{}, problemFromResponse(rsp.HTTPResponse, rsp.Body)
+	}
+
+	return *rsp.JSON200, nil
+}
+
+// Return puts the card to the back of the deck & returns the new state of the deck
+func (s *Session) Return(ctx context.Context, card Card) ([]Card, error) {
+	rsp, err := s.api.DeckReturnCardWithResponse(ctx, DeckReturnCardJSONRequestBody(card))
+	if err != nil {
+		return nil, err
+	}
+
+	if rsp.JSON200 == nil {
+		return nil, problemFromResponse(rsp.HTTPResponse, rsp.Body)
+	}
+
+	return *rsp.JSON200, nil
+}
+
+// Reset replaces the deck with a new one (sorted & standard unless the params say otherwise) & returns it
+func (s *Session) Reset(ctx context.Context, params *DeckResetParams) ([]Card, error) {
+	// the generated client does not accept nil params
+	if params == nil {
+		params = &DeckResetParams{}
+	}
+
+	rsp, err := s.api.DeckResetWithResponse(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+
+	if rsp.JSON200 == nil {
+		return nil, problemFromResponse(rsp.HTTPResponse, rsp.Body)
+	}
+
+	return *rsp.JSON200, nil
+}
+
+// sessionDoer attaches the session id to the requests & picks it up from the responses
+type sessionDoer struct {
+	session *Session
+	next    HttpRequestDoer
+}
+
+func (d *sessionDoer) Do(req *http.Request) (*http.Response, error) {
+	if id := d.session.ID(); id != "" {
+		switch d.session.transport {
+		case SessionTransportHeader:
+			req.Header.Set(SessionHeader, id)
+		default:
+			req.AddCookie(&http.Cookie{Name: SessionCookie, Value: id})
+		}
+	}
+
+	rsp, err := d.next.Do(req)
+	if err != nil {
+		return nil, err
+	}
+
+	d.session.lock.Lock()
+	defer d.session.lock.Unlock()
+
+	if id := rsp.Header.Get(SessionHeader); id != "" {
+		d.session.id = id
+	}
+
+	for _, cookie := range rsp.Cookies() {
+		if cookie.Name == SessionCookie && cookie.Value != "" {
+			d.session.id = cookie.Value
+		}
+	}
+
+	return rsp, nil
+}
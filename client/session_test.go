@@ -0,0 +1,76 @@
+package client
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeServer assigns the "abc" session to cookie-less requests & reports the deck as empty
+func fakeServer(t *testing.T, seen *[]*http.Request) *httptest.Server {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		*seen = append(*seen, r)
+
+		if _, err := r.Cookie(SessionCookie); err != nil && r.Header.Get(SessionHeader) == "" {
+			http.SetCookie(w, &http.Cookie{Name: SessionCookie, Value: "abc"})
+			w.Header().Set(SessionHeader, "abc")
+		}
+
+		w.Header().Set("Content-Type", "application/problem+json")
+		w.WriteHeader(http.StatusConflict)
+		_, _ = w.Write([]byte(`{"type":"urn:cards-http-service:problem:deck_empty","title":"The deck is empty","status":409,"detail":"the deck is empty","code":"deck_empty"}`))
+	}))
+	t.Cleanup(server.Close)
+
+	return server
+}
+
+func TestSessionCookie(t *testing.T) {
+	var seen []*http.Request
+
+	session, err := NewSession(fakeServer(t, &seen).URL)
+	require.NoError(t, err)
+	assert.Empty(t, session.ID())
+
+	_, err = session.Deal(context.Background())
+	assert.ErrorIs(t, err, ErrDeckEmpty)
+	assert.Equal(t, "abc", session.ID())
+
+	var problem *ProblemError
+	require.ErrorAs(t, err, &problem)
+	assert.Equal(t, http.StatusConflict, problem.Status)
+	assert.Equal(t, ProblemCodeDeckEmpty, problem.Code)
+
+	_, err = session.Deal(context.Background())
+	assert.Error(t, err)
+
+	require.Len(t, seen, 2)
+	cookie, err := seen[1].Cookie(SessionCookie)
+	require.NoError(t, err)
+	assert.Equal(t, "abc", cookie.Value)
+	assert.Empty(t, seen[1].Header.Get(SessionHeader))
+}
+
+func TestSessionHeader(t *testing.T) {
+	var seen []*http.Request
+
+	session, err := NewSession(
+		fakeServer(t, &seen).URL,
+		WithSessionID("xyz"),
+		WithSessionTransport(SessionTransportHeader),
+	)
+	require.NoError(t, err)
+	assert.Equal(t, "xyz", session.ID())
+
+	_, err = session.Show(context.Background())
+	assert.ErrorIs(t, err, ErrDeckEmpty)
+	assert.Equal(t, "xyz", session.ID())
+
+	require.Len(t, seen, 1)
+	assert.Equal(t, "xyz", seen[0].Header.Get(SessionHeader))
+	assert.Empty(t, seen[0].Cookies())
+}
@@ -0,0 +1,956 @@
+// Package client provides primitives to interact with the openapi HTTP API.
+//
+// Code generated by github.com/deepmap/oapi-codegen DO NOT EDIT.
+package client
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	AdminTokenScopes = "AdminToken.Scopes"
+)
+
+// Defines values for BridgeDealVulnerable.
+const (
+	BridgeDealVulnerableBoth BridgeDealVulnerable = "both"
+
+	BridgeDealVulnerableEw BridgeDealVulnerable = "ew"
+
+	BridgeDealVulnerableNone BridgeDealVulnerable = "none"
+
+	BridgeDealVulnerableNs BridgeDealVulnerable = "ns"
+)
+
+// Defines values for BridgeSeat.
+const (
+	BridgeSeatEast BridgeSeat = "east"
+
+	BridgeSeatNorth BridgeSeat = "north"
+
+	BridgeSeatSouth BridgeSeat = "south"
+
+	BridgeSeatWest BridgeSeat = "west"
+)
+
+// Defines values for GoFishGameStatus.
+const (
+	GoFishGameStatusInProgress GoFishGameStatus = "in_progress"
+
+	GoFishGameStatusOver GoFishGameStatus = "over"
+)
+
+// Defines values for HealthStatus.
+const (
+	HealthStatusOk HealthStatus = "ok"
+
+	HealthStatusUnavailable HealthStatus = "unavailable"
+)
+
+// Defines values for KlondikeGameStatus.
+const (
+	KlondikeGameStatusInProgress KlondikeGameStatus = "in_progress"
+
+	KlondikeGameStatusWon KlondikeGameStatus = "won"
+)
+
+// Defines values for KlondikeHintStatus.
+const (
+	KlondikeHintStatusSolvable KlondikeHintStatus = "solvable"
+
+	KlondikeHintStatusUnknown KlondikeHintStatus = "unknown"
+)
+
+// Defines values for ProblemCode.
+const (
+	ProblemCodeCardDuplicate ProblemCode = "card_duplicate"
+
+	ProblemCodeCardForeign ProblemCode = "card_foreign"
+
+	ProblemCodeCardMissing ProblemCode = "card_missing"
+
+	ProblemCodeCardUnparseable ProblemCode = "card_unparseable"
+
+	ProblemCodeDeckEmpty ProblemCode = "deck_empty"
+
+	ProblemCodeDeckFull ProblemCode = "deck_full"
+
+	ProblemCodeDeckShort ProblemCode = "deck_short"
+
+	ProblemCodeGameInProgress ProblemCode = "game_in_progress"
+
+	ProblemCodeGameNotFound ProblemCode = "game_not_found"
+
+	ProblemCodeGameOver ProblemCode = "game_over"
+
+	ProblemCodeIndexOutOfRange ProblemCode = "index_out_of_range"
+
+	ProblemCodeInternalError ProblemCode = "internal_error"
+
+	ProblemCodeMediaTypeUnsupported ProblemCode = "media_type_unsupported"
+
+	ProblemCodeMethodNotAllowed ProblemCode = "method_not_allowed"
+
+	ProblemCodeMoveIllegal ProblemCode = "move_illegal"
+
+	ProblemCodeNotAcceptable ProblemCode = "not_acceptable"
+
+	ProblemCodeNotFound ProblemCode = "not_found"
+
+	ProblemCodeOrderInvalid ProblemCode = "order_invalid"
+
+	ProblemCodePlayerNotFound ProblemCode = "player_not_found"
+
+	ProblemCodeRateLimited ProblemCode = "rate_limited"
+
+	ProblemCodeRequestInvalid ProblemCode = "request_invalid"
+
+	ProblemCodeSessionNotFound ProblemCode = "session_not_found"
+
+	ProblemCodeUnauthorized ProblemCode = "unauthorized"
+)
+
+// Defines values for VersionStore.
+const (
+	VersionStoreFile VersionStore = "file"
+
+	VersionStoreMemory VersionStore = "memory"
+)
+
+// Defines values for WarGameStatus.
+const (
+	WarGameStatusDraw WarGameStatus = "draw"
+
+	WarGameStatusInProgress WarGameStatus = "in_progress"
+
+	WarGameStatusWon WarGameStatus = "won"
+)
+
+// Defines values for Composition.
+const (
+	Euchre Composition = "euchre"
+
+	Piquet Composition = "piquet"
+
+	Standard Composition = "standard"
+)
+
+// Defines values for Order.
+const (
+	Given Order = "given"
+
+	Shuffled Order = "shuffled"
+
+	Sorted Order = "sorted"
+)
+
+// Defines values for ShuffleMethod.
+const (
+	FaroIn ShuffleMethod = "faro-in"
+
+	FaroOut ShuffleMethod = "faro-out"
+
+	FisherYates ShuffleMethod = "fisher-yates"
+
+	Overhand ShuffleMethod = "overhand"
+
+	Riffle ShuffleMethod = "riffle"
+
+	Strip ShuffleMethod = "strip"
+)
+
+// Defines values for SortOrder.
+const (
+	AceHigh SortOrder = "ace-high"
+
+	Bridge SortOrder = "bridge"
+
+	ByRank SortOrder = "by-rank"
+
+	NewDeck SortOrder = "new-deck"
+)
+
+// AdminSession defines model for AdminSession.
+type AdminSession struct {
+	Cards []Card `json:"cards"`
+	Id    string `json:"id"`
+}
+
+// AdminSessionPage defines model for AdminSessionPage.
+type AdminSessionPage struct {
+
+	// The 'after' parameter of the next page; missing on the last page
+	Next     *string               `json:"next,omitempty"`
+	Sessions []AdminSessionSummary `json:"sessions"`
+}
+
+// AdminSessionSummary defines model for AdminSessionSummary.
+type AdminSessionSummary struct {
+	Id string `json:"id"`
+
+	// The number of cards in the session's deck
+	Size int `json:"size"`
+}
+
+// The sum of the blackjack points of the remaining cards
+type BlackjackPoints struct {
+
+	// The aces counted as 11
+	High int `json:"high"`
+
+	// The aces counted as 1
+	Low int `json:"low"`
+}
+
+// BridgeDeal defines model for BridgeDeal.
+type BridgeDeal struct {
+	Board  int        `json:"board"`
+	Dealer BridgeSeat `json:"dealer"`
+
+	// The hands of north, east, south & west
+	Hands      []BridgeHand         `json:"hands"`
+	Vulnerable BridgeDealVulnerable `json:"vulnerable"`
+}
+
+// BridgeDealVulnerable defines model for BridgeDeal.Vulnerable.
+type BridgeDealVulnerable string
+
+// BridgeHand defines model for BridgeHand.
+type BridgeHand struct {
+
+	// The cards by suit (spades, hearts, diamonds, clubs) from the ace down
+	Cards []Card `json:"cards"`
+
+	// The high-card points (4 for an ace, 3 for a king, 2 for a queen & 1 for a jack)
+	Hcp  int        `json:"hcp"`
+	Seat BridgeSeat `json:"seat"`
+}
+
+// The Board, Dealer, Vulnerable & Deal tags of every board in the Portable Bridge Notation
+type BridgePBN string
+
+// BridgeSeat defines model for BridgeSeat.
+type BridgeSeat string
+
+// Card defines model for Card.
+type Card struct {
+	Suit  string `json:"suit"`
+	Value string `json:"value"`
+}
+
+// Any of the short ("ah"), long ("ace of hearts"), suit symbol ("A♥") or unicode glyph ("🂱") forms of a card
+type CardText string
+
+// Comma-separated 'value,suit' records with a header
+type DeckCSV string
+
+// DeckOdds defines model for DeckOdds.
+type DeckOdds struct {
+	AtLeast int    `json:"at_least"`
+	Draws   int    `json:"draws"`
+	Event   string `json:"event"`
+
+	// The number of the remaining cards of the kind
+	Matching    int     `json:"matching"`
+	Probability float64 `json:"probability"`
+	Remaining   int     `json:"remaining"`
+}
+
+// DeckStats defines model for DeckStats.
+type DeckStats struct {
+
+	// The sum of the blackjack points of the remaining cards
+	Blackjack BlackjackPoints `json:"blackjack"`
+	Remaining int             `json:"remaining"`
+
+	// The number of the remaining cards of each suit
+	Suits DeckStats_Suits `json:"suits"`
+
+	// The number of the remaining cards of each value
+	Values DeckStats_Values `json:"values"`
+}
+
+// The number of the remaining cards of each suit
+type DeckStats_Suits struct {
+	AdditionalProperties map[string]int `json:"-"`
+}
+
+// The number of the remaining cards of each value
+type DeckStats_Values struct {
+	AdditionalProperties map[string]int `json:"-"`
+}
+
+// Compact (text/plain), unicode glyph (text/plain; format=glyph) or suit symbol (text/plain; format=symbol) representation of the deck
+type DeckText string
+
+// GoFishAsk defines model for GoFishAsk.
+type GoFishAsk struct {
+	Player GoFishPlayerName `json:"player"`
+	Target GoFishPlayerName `json:"target"`
+
+	// The value asked for in the short (q) or long (queen) form
+	Value string `json:"value"`
+}
+
+// GoFishGame defines model for GoFishGame.
+type GoFishGame struct {
+
+	// The cards of the viewing player
+	Hand    *[]Card          `json:"hand,omitempty"`
+	Players []GoFishPlayer   `json:"players"`
+	Status  GoFishGameStatus `json:"status"`
+
+	// The number of the cards left to draw
+	Stock int               `json:"stock"`
+	Turn  *GoFishPlayerName `json:"turn,omitempty"`
+
+	// The turns played since the viewing player's last ask, starting with it (the last round of turns without a viewing player)
+	Turns []GoFishTurn `json:"turns"`
+
+	// The players with the most books once the game is over
+	Winners *[]GoFishPlayerName `json:"winners,omitempty"`
+}
+
+// GoFishGameStatus defines model for GoFishGame.Status.
+type GoFishGameStatus string
+
+// GoFishPlayer defines model for GoFishPlayer.
+type GoFishPlayer struct {
+
+	// The values of the books laid down
+	Books []string `json:"books"`
+	Bot   *string  `json:"bot,omitempty"`
+
+	// The number of the cards in the player's hand
+	Cards int              `json:"cards"`
+	Name  GoFishPlayerName `json:"name"`
+}
+
+// GoFishPlayerName defines model for GoFishPlayerName.
+type GoFishPlayerName string
+
+// GoFishSeat defines model for GoFishSeat.
+type GoFishSeat struct {
+
+	// The strategy of a bot (basic or memory), left out for the players asking through the api
+	Bot  *string          `json:"bot,omitempty"`
+	Name GoFishPlayerName `json:"name"`
+}
+
+// GoFishSetup defines model for GoFishSetup.
+type GoFishSetup struct {
+
+	// The players in the order of their turns
+	Players []GoFishSeat `json:"players"`
+}
+
+// GoFishTurn defines model for GoFishTurn.
+type GoFishTurn struct {
+
+	// Whether the player goes on
+	Again bool `json:"again"`
+
+	// The values of the books laid down
+	Books []string `json:"books"`
+
+	// The number of the cards drawn from the stock
+	Drawn int `json:"drawn"`
+
+	// The number of the cards handed over by the target
+	Got    int               `json:"got"`
+	Player GoFishPlayerName  `json:"player"`
+	Target *GoFishPlayerName `json:"target,omitempty"`
+
+	// The value asked for; left out with the target when the player drew a card into an empty hand
+	Value *string `json:"value,omitempty"`
+}
+
+// Health defines model for Health.
+type Health struct {
+	Status HealthStatus `json:"status"`
+}
+
+// HealthStatus defines model for Health.Status.
+type HealthStatus string
+
+// KlondikeColumn defines model for KlondikeColumn.
+type KlondikeColumn struct {
+
+	// The face-up cards from the bottom to the top
+	Cards []Card `json:"cards"`
+
+	// The number of the face-down cards
+	Hidden int `json:"hidden"`
+}
+
+// KlondikeGame defines model for KlondikeGame.
+type KlondikeGame struct {
+	Draw int `json:"draw"`
+
+	// The number of the cards on the foundation of each suit; the top card is the one of that value (e.g. 3 for the three)
+	Foundations KlondikeGame_Foundations `json:"foundations"`
+
+	// The number of the moves made
+	Moves  int                `json:"moves"`
+	Status KlondikeGameStatus `json:"status"`
+
+	// The number of the face-down cards in the stock
+	Stock   int              `json:"stock"`
+	Tableau []KlondikeColumn `json:"tableau"`
+
+	// The cards turned from the stock from the bottom to the top
+	Waste []Card `json:"waste"`
+}
+
+// The number of the cards on the foundation of each suit; the top card is the one of that value (e.g. 3 for the three)
+type KlondikeGame_Foundations struct {
+	AdditionalProperties map[string]int `json:"-"`
+}
+
+// KlondikeGameStatus defines model for KlondikeGame.Status.
+type KlondikeGameStatus string
+
+// KlondikeHint defines model for KlondikeHint.
+type KlondikeHint struct {
+
+	// The number of the positions searched
+	Explored int           `json:"explored"`
+	Move     *KlondikeMove `json:"move,omitempty"`
+
+	// The number of the moves in the solution
+	Moves *int `json:"moves,omitempty"`
+
+	// Whether a solution was found (the game may still be winnable when it is unknown)
+	Status KlondikeHintStatus `json:"status"`
+}
+
+// Whether a solution was found (the game may still be winnable when it is unknown)
+type KlondikeHintStatus string
+
+// KlondikeMove defines model for KlondikeMove.
+type KlondikeMove struct {
+
+	// The number of the cards moved off a tableau column
+	Count *int `json:"count,omitempty"`
+
+	// The stock, the waste, a foundation by its suit (fc, fh, fd or fs) or a tableau column (t1 to t7)
+	From KlondikePile `json:"from"`
+
+	// The stock, the waste, a foundation by its suit (fc, fh, fd or fs) or a tableau column (t1 to t7)
+	To KlondikePile `json:"to"`
+}
+
+// The stock, the waste, a foundation by its suit (fc, fh, fd or fs) or a tableau column (t1 to t7)
+type KlondikePile string
+
+// Problem defines model for Problem.
+type Problem struct {
+	Code   ProblemCode `json:"code"`
+	Detail string      `json:"detail"`
+	Status int         `json:"status"`
+	Title  string      `json:"title"`
+	Type   string      `json:"type"`
+}
+
+// ProblemCode defines model for Problem.Code.
+type ProblemCode string
+
+// Version defines model for Version.
+type Version struct {
+
+	// Whether the working tree had uncommitted changes at build time
+	Modified *bool `json:"modified,omitempty"`
+
+	// The vcs revision the service was built from, empty when unknown
+	Revision string `json:"revision"`
+
+	// The session store backend
+	Store VersionStore `json:"store"`
+
+	// The module version, '(devel)' when built from a source checkout
+	Version string `json:"version"`
+}
+
+// The session store backend
+type VersionStore string
+
+// WarGame defines model for WarGame.
+type WarGame struct {
+
+	// The number of the cards in the players' piles
+	Piles []int `json:"piles"`
+
+	// The number of the rounds played
+	Rounds int           `json:"rounds"`
+	Status WarGameStatus `json:"status"`
+
+	// The player (1 or 2) who won the game; missing unless the game is won
+	Winner *int `json:"winner,omitempty"`
+}
+
+// WarGameStatus defines model for WarGame.Status.
+type WarGameStatus string
+
+// WarRound defines model for WarRound.
+type WarRound struct {
+	Game   WarGame `json:"game"`
+	Number int     `json:"number"`
+
+	// The cards each player put down in the order they were played; in a war, every player's face-down cards are followed by their face-up one
+	Played [][]Card `json:"played"`
+
+	// The number of the ties in the round
+	Wars int `json:"wars"`
+
+	// The player (1 or 2) who took the cards, 0 if both ran out of cards during a war
+	Winner int `json:"winner"`
+}
+
+// Board defines model for Board.
+type Board int
+
+// BoardCount defines model for BoardCount.
+type BoardCount int
+
+// Composition defines model for Composition.
+type Composition string
+
+// FaceDown defines model for FaceDown.
+type FaceDown bool
+
+// Fan defines model for Fan.
+type Fan bool
+
+// FirstBoard defines model for FirstBoard.
+type FirstBoard int
+
+// Index defines model for Index.
+type Index int
+
+// Order defines model for Order.
+type Order string
+
+// OrderCards defines model for OrderCards.
+type OrderCards string
+
+// Player defines model for Player.
+type Player GoFishPlayerName
+
+// Seed defines model for Seed.
+type Seed int64
+
+// SessionId defines model for SessionId.
+type SessionId string
+
+// ShuffleMethod defines model for ShuffleMethod.
+type ShuffleMethod string
+
+// SortOrder defines model for SortOrder.
+type SortOrder string
+
+// Times defines model for Times.
+type Times int
+
+// AdminListSessionsParams defines parameters for AdminListSessions.
+type AdminListSessionsParams struct {
+
+	// Only list the sessions after this id (the 'next' id of the previous page)
+	After *string `json:"after,omitempty"`
+
+	// The maximum number of sessions in the page
+	Limit *int `json:"limit,omitempty"`
+}
+
+// BridgeBoardsParams defines parameters for BridgeBoards.
+type BridgeBoardsParams struct {
+
+	// The seed of the set of boards
+	Seed Seed `json:"seed"`
+
+	// The number of the first board in the set
+	First *FirstBoard `json:"first,omitempty"`
+
+	// The number of the boards in the set
+	Count *BoardCount `json:"count,omitempty"`
+}
+
+// BridgeBoardsPbnParams defines parameters for BridgeBoardsPbn.
+type BridgeBoardsPbnParams struct {
+
+	// The seed of the set of boards
+	Seed Seed `json:"seed"`
+
+	// The number of the first board in the set
+	First *FirstBoard `json:"first,omitempty"`
+
+	// The number of the boards in the set
+	Count *BoardCount `json:"count,omitempty"`
+}
+
+// BridgeDealParams defines parameters for BridgeDeal.
+type BridgeDealParams struct {
+
+	// The board number, which sets the dealer & the vulnerability
+	Board *Board `json:"board,omitempty"`
+}
+
+// BridgeDealPbnParams defines parameters for BridgeDealPbn.
+type BridgeDealPbnParams struct {
+
+	// The board number, which sets the dealer & the vulnerability
+	Board *Board `json:"board,omitempty"`
+}
+
+// CardRenderSvgParams defines parameters for CardRenderSvg.
+type CardRenderSvgParams struct {
+
+	// Show the backs of the cards rather than their faces
+	FaceDown *FaceDown `json:"face_down,omitempty"`
+}
+
+// DeckRenderSvgParams defines parameters for DeckRenderSvg.
+type DeckRenderSvgParams struct {
+
+	// Fan the cards out in an arc rather than laying them in a row
+	Fan *Fan `json:"fan,omitempty"`
+
+	// Show the backs of the cards rather than their faces
+	FaceDown *FaceDown `json:"face_down,omitempty"`
+}
+
+// DeckCutParams defines parameters for DeckCut.
+type DeckCutParams struct {
+
+	// The number of the cards to move; both of the packets must not be empty
+	Count int `json:"count"`
+}
+
+// DeckDealAtParams defines parameters for DeckDealAt.
+type DeckDealAtParams struct {
+
+	// The position in the deck, 0 being the top
+	Index Index `json:"index"`
+}
+
+// DeckDrawCardJSONBody defines parameters for DeckDrawCard.
+type DeckDrawCardJSONBody Card
+
+// DeckInsertCardJSONBody defines parameters for DeckInsertCard.
+type DeckInsertCardJSONBody Card
+
+// DeckInsertCardParams defines parameters for DeckInsertCard.
+type DeckInsertCardParams struct {
+
+	// The position in the deck, 0 being the top
+	Index Index `json:"index"`
+}
+
+// DeckOddsParams defines parameters for DeckOdds.
+type DeckOddsParams struct {
+
+	// The kind of the cards to draw: a category (red, black or face), a suit (hearts), a value (ace) or a single card (as or ace of spades)
+	Event string `json:"event"`
+
+	// The number of the next cards to draw
+	Draws *int `json:"draws,omitempty"`
+
+	// The number of the drawn cards that must be of the kind
+	AtLeast *int `json:"at_least,omitempty"`
+}
+
+// DeckPeekParams defines parameters for DeckPeek.
+type DeckPeekParams struct {
+
+	// The number of the cards to look at; fewer are returned if the deck is shorter
+	Count *int `json:"count,omitempty"`
+}
+
+// DeckReset2Params defines parameters for DeckReset2.
+type DeckReset2Params struct {
+
+	// The order of the new deck; 'given' arranges the cards as in the 'cards' parameter
+	Order *DeckReset2ParamsOrder `json:"order,omitempty"`
+
+	// All of the deck's cards in the short form from top to bottom (required by order=given)
+	Cards *OrderCards `json:"cards,omitempty"`
+
+	// The cards the new deck is made of: all 52 of them (standard), the sevens up and the aces (piquet) or the nines up and the aces (euchre)
+	Composition *DeckReset2ParamsComposition `json:"composition,omitempty"`
+}
+
+// DeckReset2ParamsOrder defines parameters for DeckReset2.
+type DeckReset2ParamsOrder string
+
+// DeckReset2ParamsComposition defines parameters for DeckReset2.
+type DeckReset2ParamsComposition string
+
+// DeckResetParams defines parameters for DeckReset.
+type DeckResetParams struct {
+
+	// The order of the new deck; 'given' arranges the cards as in the 'cards' parameter
+	Order *DeckResetParamsOrder `json:"order,omitempty"`
+
+	// All of the deck's cards in the short form from top to bottom (required by order=given)
+	Cards *OrderCards `json:"cards,omitempty"`
+
+	// The cards the new deck is made of: all 52 of them (standard), the sevens up and the aces (piquet) or the nines up and the aces (euchre)
+	Composition *DeckResetParamsComposition `json:"composition,omitempty"`
+}
+
+// DeckResetParamsOrder defines parameters for DeckReset.
+type DeckResetParamsOrder string
+
+// DeckResetParamsComposition defines parameters for DeckReset.
+type DeckResetParamsComposition string
+
+// DeckReturnCard2Params defines parameters for DeckReturnCard2.
+type DeckReturnCard2Params struct {
+
+	// Short-form, long-form, suit symbol or unicode glyph encoding of the card to return to the deck
+	Card *string `json:"card,omitempty"`
+}
+
+// DeckReturnCardJSONBody defines parameters for DeckReturnCard.
+type DeckReturnCardJSONBody Card
+
+// DeckShuffle2Params defines parameters for DeckShuffle2.
+type DeckShuffle2Params struct {
+
+	// A perfectly random permutation (fisher-yates), a Gilbert-Shannon-Reeds riffle, an overhand or a strip shuffle, or a perfect faro keeping the top card on top (faro-out) or moving it to the second place (faro-in)
+	Method *DeckShuffle2ParamsMethod `json:"method,omitempty"`
+
+	// The number of times to repeat the shuffle
+	Times *Times `json:"times,omitempty"`
+}
+
+// DeckShuffle2ParamsMethod defines parameters for DeckShuffle2.
+type DeckShuffle2ParamsMethod string
+
+// DeckShuffleParams defines parameters for DeckShuffle.
+type DeckShuffleParams struct {
+
+	// A perfectly random permutation (fisher-yates), a Gilbert-Shannon-Reeds riffle, an overhand or a strip shuffle, or a perfect faro keeping the top card on top (faro-out) or moving it to the second place (faro-in)
+	Method *DeckShuffleParamsMethod `json:"method,omitempty"`
+
+	// The number of times to repeat the shuffle
+	Times *Times `json:"times,omitempty"`
+}
+
+// DeckShuffleParamsMethod defines parameters for DeckShuffle.
+type DeckShuffleParamsMethod string
+
+// DeckSortParams defines parameters for DeckSort.
+type DeckSortParams struct {
+
+	// The suits in the new deck order (clubs, hearts, diamonds, spades) with the aces low (new-deck) or high (ace-high), the bridge order (clubs, diamonds, hearts, spades with the aces high) or the values first with the aces low (by-rank)
+	Order *DeckSortParamsOrder `json:"order,omitempty"`
+}
+
+// DeckSortParamsOrder defines parameters for DeckSort.
+type DeckSortParamsOrder string
+
+// GoFishShowParams defines parameters for GoFishShow.
+type GoFishShowParams struct {
+
+	// The player whose hand is shown
+	Player *Player `json:"player,omitempty"`
+}
+
+// GoFishStartJSONBody defines parameters for GoFishStart.
+type GoFishStartJSONBody GoFishSetup
+
+// GoFishAskJSONBody defines parameters for GoFishAsk.
+type GoFishAskJSONBody GoFishAsk
+
+// KlondikeStartParams defines parameters for KlondikeStart.
+type KlondikeStartParams struct {
+
+	// The number of the cards turned from the stock to the waste at a time
+	Draw *KlondikeStartParamsDraw `json:"draw,omitempty"`
+}
+
+// KlondikeStartParamsDraw defines parameters for KlondikeStart.
+type KlondikeStartParamsDraw int
+
+// KlondikeMoveJSONBody defines parameters for KlondikeMove.
+type KlondikeMoveJSONBody KlondikeMove
+
+// DeckDrawCardJSONRequestBody defines body for DeckDrawCard for application/json ContentType.
+type DeckDrawCardJSONRequestBody DeckDrawCardJSONBody
+
+// DeckInsertCardJSONRequestBody defines body for DeckInsertCard for application/json ContentType.
+type DeckInsertCardJSONRequestBody DeckInsertCardJSONBody
+
+// DeckReturnCardJSONRequestBody defines body for DeckReturnCard for application/json ContentType.
+type DeckReturnCardJSONRequestBody DeckReturnCardJSONBody
+
+// GoFishStartJSONRequestBody defines body for GoFishStart for application/json ContentType.
+type GoFishStartJSONRequestBody GoFishStartJSONBody
+
+// GoFishAskJSONRequestBody defines body for GoFishAsk for application/json ContentType.
+type GoFishAskJSONRequestBody GoFishAskJSONBody
+
+// KlondikeMoveJSONRequestBody defines body for KlondikeMove for application/json ContentType.
+type KlondikeMoveJSONRequestBody KlondikeMoveJSONBody
+
+// Getter for additional properties for DeckStats_Suits. Returns the specified
+// element and whether it was found
+func (a DeckStats_Suits) Get(fieldName string) (value int, found bool) {
+	if a.AdditionalProperties != nil {
+		value, found = a.AdditionalProperties[fieldName]
+	}
+	return
+}
+
+// Setter for additional properties for DeckStats_Suits
+func (a *DeckStats_Suits) Set(fieldName string, value int) {
+	if a.AdditionalProperties == nil {
+		a.AdditionalProperties = make(map[string]int)
+	}
+	a.AdditionalProperties[fieldName] = value
+}
+
+// Override default JSON handling for DeckStats_Suits to handle AdditionalProperties
+func (a *DeckStats_Suits) UnmarshalJSON(b []byte) error {
+	object := make(map[string]json.RawMessage)
+	err := json.Unmarshal(b, &object)
+	if err != nil {
+		return err
+	}
+
+	if len(object) != 0 {
+		a.AdditionalProperties = make(map[string]int)
+		for fieldName, fieldBuf := range object {
+			var fieldVal int
+			err := json.Unmarshal(fieldBuf, &fieldVal)
+			if err != nil {
+				return errors.Wrap(err, fmt.Sprintf("error unmarshaling field %s", fieldName))
+			}
+			a.AdditionalProperties[fieldName] = fieldVal
+		}
+	}
+	return nil
+}
+
+// Override default JSON handling for DeckStats_Suits to handle AdditionalProperties
+func (a DeckStats_Suits) MarshalJSON() ([]byte, error) {
+	var err error
+	object := make(map[string]json.RawMessage)
+
+	for fieldName, field := range a.AdditionalProperties {
+		object[fieldName], err = json.Marshal(field)
+		if err != nil {
+			return nil, errors.Wrap(err, fmt.Sprintf("error marshaling '%s'", fieldName))
+		}
+	}
+	return json.Marshal(object)
+}
+
+// Getter for additional properties for DeckStats_Values. Returns the specified
+// element and whether it was found
+func (a DeckStats_Values) Get(fieldName string) (value int, found bool) {
+	if a.AdditionalProperties != nil {
+		value, found = a.AdditionalProperties[fieldName]
+	}
+	return
+}
+
+// Setter for additional properties for DeckStats_Values
+func (a *DeckStats_Values) Set(fieldName string, value int) {
+	if a.AdditionalProperties == nil {
+		a.AdditionalProperties = make(map[string]int)
+	}
+	a.AdditionalProperties[fieldName] = value
+}
+
+// Override default JSON handling for DeckStats_Values to handle AdditionalProperties
+func (a *DeckStats_Values) UnmarshalJSON(b []byte) error {
+	object := make(map[string]json.RawMessage)
+	err := json.Unmarshal(b, &object)
+	if err != nil {
+		return err
+	}
+
+	if len(object) != 0 {
+		a.AdditionalProperties = make(map[string]int)
+		for fieldName, fieldBuf := range object {
+			var fieldVal int
+			err := json.Unmarshal(fieldBuf, &fieldVal)
+			if err != nil {
+				return errors.Wrap(err, fmt.Sprintf("error unmarshaling field %s", fieldName))
+			}
+			a.AdditionalProperties[fieldName] = fieldVal
+		}
+	}
+	return nil
+}
+
+// Override default JSON handling for DeckStats_Values to handle AdditionalProperties
+func (a DeckStats_Values) MarshalJSON() ([]byte, error) {
+	var err error
+	object := make(map[string]json.RawMessage)
+
+	for fieldName, field := range a.AdditionalProperties {
+		object[fieldName], err = json.Marshal(field)
+		if err != nil {
+			return nil, errors.Wrap(err, fmt.Sprintf("error marshaling '%s'", fieldName))
+		}
+	}
+	return json.Marshal(object)
+}
+
+// Getter for additional properties for KlondikeGame_Foundations. Returns the specified
+// element and whether it was found
+func (a KlondikeGame_Foundations) Get(fieldName string) (value int, found bool) {
+	if a.AdditionalProperties != nil {
+		value, found = a.AdditionalProperties[fieldName]
+	}
+	return
+}
+
+// Setter for additional properties for KlondikeGame_Foundations
+func (a *KlondikeGame_Foundations) Set(fieldName string, value int) {
+	if a.AdditionalProperties == nil {
+		a.AdditionalProperties = make(map[string]int)
+	}
+	a.AdditionalProperties[fieldName] = value
+}
+
+// Override default JSON handling for KlondikeGame_Foundations to handle AdditionalProperties
+func (a *KlondikeGame_Foundations) UnmarshalJSON(b []byte) error {
+	object := make(map[string]json.RawMessage)
+	err := json.Unmarshal(b, &object)
+	if err != nil {
+		return err
+	}
+
+	if len(object) != 0 {
+		a.AdditionalProperties = make(map[string]int)
+		for fieldName, fieldBuf := range object {
+			var fieldVal int
+			err := json.Unmarshal(fieldBuf, &fieldVal)
+			if err != nil {
+				return errors.Wrap(err, fmt.Sprintf("error unmarshaling field %s", fieldName))
+			}
+			a.AdditionalProperties[fieldName] = fieldVal
+		}
+	}
+	return nil
+}
+
+// Override default JSON handling for KlondikeGame_Foundations to handle AdditionalProperties
+func (a KlondikeGame_Foundations) MarshalJSON() ([]byte, error) {
+	var err error
+	object := make(map[string]json.RawMessage)
+
+	for fieldName, field := range a.AdditionalProperties {
+		object[fieldName], err = json.Marshal(field)
+		if err != nil {
+			return nil, errors.Wrap(err, fmt.Sprintf("error marshaling '%s'", fieldName))
+		}
+	}
+	return json.Marshal(object)
+}
@@ -0,0 +1,313 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/AntonAverchenkov/cards-http-service/client"
+	"github.com/AntonAverchenkov/cards-http-service/internal/game"
+)
+
+// app holds the state shared by all the commands
+type app struct {
+	opts *Options
+}
+
+// session connects to the server, resuming the locally stored session if there is one
+func (a *app) session() (*client.Session, error) {
+	path, err := a.opts.sessionFile()
+	if err != nil {
+		return nil, err
+	}
+
+	var opts []client.SessionOption
+
+	b, err := ioutil.ReadFile(path)
+	switch {
+	case err == nil:
+		if id := strings.TrimSpace(string(b)); id != "" {
+			opts = append(opts, client.WithSessionID(id))
+		}
+	case !errors.Is(err, os.ErrNotExist):
+		return nil, fmt.Errorf("could not read %q: %w", path, err)
+	}
+
+	return client.NewSession(a.opts.Server, opts...)
+}
+
+// save stores the session id, which may have been assigned by the server
+func (a *app) save(session *client.Session) error {
+	return a.store(session.ID())
+}
+
+func (a *app) store(id string) error {
+	path, err := a.opts.sessionFile()
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(filepath.Dir(path), 0700); err != nil {
+		return fmt.Errorf("could not create %q: %w", filepath.Dir(path), err)
+	}
+
+	if err := ioutil.WriteFile(path, []byte(id+"\n"), 0600); err != nil {
+		return fmt.Errorf("could not write %q: %w", path, err)
+	}
+
+	return nil
+}
+
+// print writes the cards either as JSON or as space-separated short forms ("ac 2c 3c")
+func (a *app) print(cards ...client.Card) error {
+	if a.opts.JSON {
+		b, err := json.MarshalIndent(cards, "", "  ")
+		if err != nil {
+			return err
+		}
+
+		fmt.Println(string(b))
+
+		return nil
+	}
+
+	tokens := make([]string, 0, len(cards))
+
+	for _, c := range cards {
+		card, err := game.ParseCard(c.Value + " of " + c.Suit)
+		if err != nil {
+			return err
+		}
+
+		tokens = append(tokens, card.ShortString())
+	}
+
+	fmt.Println(strings.Join(tokens, " "))
+
+	return nil
+}
+
+type showCommand struct {
+	*app
+}
+
+func (c *showCommand) Execute([]string) error {
+	session, err := c.session()
+	if err != nil {
+		return err
+	}
+
+	cards, err := session.Show(context.Background())
+	if err != nil {
+		return err
+	}
+
+	if err := c.save(session); err != nil {
+		return err
+	}
+
+	return c.print(cards...)
+}
+
+type shuffleCommand struct {
+	*app
+
+	Method string `long:"method" description:"The shuffle method" choice:"fisher-yates" choice:"riffle" choice:"overhand" choice:"strip" choice:"faro-out" choice:"faro-in" default:"fisher-yates"`
+	Times  int    `long:"times"  description:"The number of times to repeat the shuffle" default:"1"`
+}
+
+func (c *shuffleCommand) Execute([]string) error {
+	session, err := c.session()
+	if err != nil {
+		return err
+	}
+
+	cards, err := session.ShuffleWith(context.Background(), &client.DeckShuffleParams{
+		Method: (*client.DeckShuffleParamsMethod)(&c.Method),
+		Times:  (*client.Times)(&c.Times),
+	})
+	if err != nil {
+		return err
+	}
+
+	if err := c.save(session); err != nil {
+		return err
+	}
+
+	return c.print(cards...)
+}
+
+type sortCommand struct {
+	*app
+
+	Order string `long:"order" description:"The order to sort the deck in" choice:"new-deck" choice:"ace-high" choice:"bridge" choice:"by-rank" default:"new-deck"`
+}
+
+func (c *sortCommand) Execute([]string) error {
+	session, err := c.session()
+	if err != nil {
+		return err
+	}
+
+	cards, err := session.Sort(context.Background(), &client.DeckSortParams{
+		Order: (*client.DeckSortParamsOrder)(&c.Order),
+	})
+	if err != nil {
+		return err
+	}
+
+	if err := c.save(session); err != nil {
+		return err
+	}
+
+	return c.print(cards...)
+}
+
+type dealCommand struct {
+	*app
+
+	Count int `short:"n" long:"count" description:"The number of cards to deal" default:"1"`
+}
+
+func (c *dealCommand) Execute([]string) error {
+	if c.Count < 1 {
+		return fmt.Errorf("the number of cards to deal must be positive; got %d", c.Count)
+	}
+
+	session, err := c.session()
+	if err != nil {
+		return err
+	}
+
+	var hand []client.Card
+
+	for i := 0; i < c.Count; i++ {
+		card, err := session.Deal(context.Background())
+		if err != nil {
+			// report the cards that were dealt before the deck ran out
+			if len(hand) != 0 {
+				_ = c.print(hand...)
+			}
+			return err
+		}
+
+		hand = append(hand, card)
+	}
+
+	if err := c.save(session); err != nil {
+		return err
+	}
+
+	return c.print(hand...)
+}
+
+type returnCommand struct {
+	*app
+
+	Args struct {
+		Card []string `positional-arg-name:"card" description:"Short ('qh') or long ('queen of hearts') form of the card" required:"1"`
+	} `positional-args:"yes" required:"yes"`
+}
+
+func (c *returnCommand) Execute([]string) error {
+	// the long form may be passed unquoted as several arguments
+	card, err := game.ParseCard(strings.Join(c.Args.Card, " "))
+	if err != nil {
+		return err
+	}
+
+	session, err := c.session()
+	if err != nil {
+		return err
+	}
+
+	cards, err := session.Return(context.Background(), client.Card{
+		Value: card.Value.String(),
+		Suit:  card.Suit.String(),
+	})
+	if err != nil {
+		return err
+	}
+
+	if err := c.save(session); err != nil {
+		return err
+	}
+
+	return c.print(cards...)
+}
+
+type resetCommand struct {
+	*app
+
+	Order       string `long:"order"       description:"The order of the new deck" choice:"sorted" choice:"shuffled" choice:"given" default:"sorted"`
+	Cards       string `long:"cards"       description:"All of the deck's cards in the short form from top to bottom (with --order=given)"`
+	Composition string `long:"composition" description:"The cards the new deck is made of" choice:"standard" choice:"piquet" choice:"euchre" default:"standard"`
+}
+
+func (c *resetCommand) Execute([]string) error {
+	session, err := c.session()
+	if err != nil {
+		return err
+	}
+
+	params := client.DeckResetParams{
+		Order:       (*client.DeckResetParamsOrder)(&c.Order),
+		Composition: (*client.DeckResetParamsComposition)(&c.Composition),
+	}
+
+	if c.Cards != "" {
+		params.Cards = (*client.OrderCards)(&c.Cards)
+	}
+
+	cards, err := session.Reset(context.Background(), &params)
+	if err != nil {
+		return err
+	}
+
+	if err := c.save(session); err != nil {
+		return err
+	}
+
+	return c.print(cards...)
+}
+
+type sessionNewCommand struct {
+	*app
+}
+
+func (c *sessionNewCommand) Execute([]string) error {
+	// the server assigns a new session to the requests without one
+	session, err := client.NewSession(c.opts.Server)
+	if err != nil {
+		return err
+	}
+
+	if _, err := session.Show(context.Background()); err != nil {
+		return err
+	}
+
+	if err := c.save(session); err != nil {
+		return err
+	}
+
+	fmt.Println(session.ID())
+
+	return nil
+}
+
+type sessionUseCommand struct {
+	*app
+
+	Args struct {
+		ID string `positional-arg-name:"id" description:"The id of the session to switch to"`
+	} `positional-args:"yes" required:"yes"`
+}
+
+func (c *sessionUseCommand) Execute([]string) error {
+	return c.store(c.Args.ID)
+}
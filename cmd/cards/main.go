@@ -0,0 +1,68 @@
+// Command cards drives the cards-http-service from a terminal:
+//
+//	cards show
+//	cards shuffle --method riffle --times 7
+//	cards sort --order bridge
+//	cards deal -n 5
+//	cards return "queen of hearts"
+//	cards reset --order shuffled --composition piquet
+//	cards session new
+//	cards session use <id>
+//
+// The session id is kept in a local file between the invocations.
+package main
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/jessevdk/go-flags"
+)
+
+type Options struct {
+	Server      string `long:"server"       env:"CARDS_SERVER"       description:"The base url of the cards-http-service"         default:"http://localhost:8080"`
+	SessionFile string `long:"session-file" env:"CARDS_SESSION_FILE" description:"Keep the session id in this file (defaults to the user's config directory)"`
+	JSON        bool   `long:"json"         description:"Print the cards as JSON rather than the compact short form"`
+}
+
+func main() {
+	var opts Options
+
+	parser := flags.NewParser(&opts, flags.Default)
+
+	app := &app{opts: &opts}
+
+	parser.AddCommand("show", "Show the deck", "Print the current state of the deck from top to bottom", &showCommand{app})
+	parser.AddCommand("shuffle", "Shuffle the deck", "Permute the deck in an unbiased way (or with a model of a human shuffle) & print it", &shuffleCommand{app: app})
+	parser.AddCommand("sort", "Sort the deck", "Sort the deck in the given order & print it", &sortCommand{app: app})
+	parser.AddCommand("deal", "Deal cards", "Deal the top card(s) by removing them from the deck", &dealCommand{app: app})
+	parser.AddCommand("return", "Return a card", "Return the card (e.g. 'qh' or 'queen of hearts') to the back of the deck", &returnCommand{app: app})
+	parser.AddCommand("reset", "Reset the deck", "Replace the deck with a new one in the given order & print it", &resetCommand{app: app})
+
+	session, _ := parser.AddCommand("session", "Manage the session", "Start a new session or switch to an existing one", &struct{}{})
+	session.AddCommand("new", "Start a new session", "Forget the current session & start a new one with a fresh deck", &sessionNewCommand{app})
+	session.AddCommand("use", "Use an existing session", "Switch to the session with the given id", &sessionUseCommand{app: app})
+
+	// the errors (including the ones returned by the commands) are printed by the parser
+	if _, err := parser.Parse(); err != nil {
+		if e, ok := err.(*flags.Error); ok && e.Type == flags.ErrHelp {
+			os.Exit(0)
+		}
+		os.Exit(1)
+	}
+}
+
+// sessionFile returns the path of the file that keeps the session id
+func (o *Options) sessionFile() (string, error) {
+	if o.SessionFile != "" {
+		return o.SessionFile, nil
+	}
+
+	dir, err := os.UserConfigDir()
+	if err != nil {
+		return "", fmt.Errorf("could not find the user's config directory: %w", err)
+	}
+
+	return filepath.Join(dir, "cards-http-service", "session"), nil
+}
@@ -0,0 +1,279 @@
+package main
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/AntonAverchenkov/cards-http-service/internal/api"
+	"github.com/AntonAverchenkov/cards-http-service/internal/game"
+	"github.com/AntonAverchenkov/cards-http-service/internal/game/gofish"
+	"github.com/AntonAverchenkov/cards-http-service/internal/state"
+	"github.com/labstack/echo/v4"
+)
+
+// (GET /games/gofish?player={player}) : get the state of the go fish game as seen by the player
+func (h *handlers) GoFishShow(ctx echo.Context, params api.GoFishShowParams) error {
+	h.lock.Lock()
+	defer h.lock.Unlock()
+
+	session, err := h.fetchSessionSetCookie(ctx)
+	if err != nil {
+		return Problem(ctx, err)
+	}
+
+	g, err := gofishGame(session)
+	if err != nil {
+		return Problem(ctx, err)
+	}
+
+	viewer := gofish.NoTarget
+	if params.Player != nil {
+		if viewer, err = g.Find(string(*params.Player)); err != nil {
+			return Problem(ctx, err)
+		}
+	}
+
+	return JSON(ctx, http.StatusOK, fromGoFishGame(g, viewer))
+}
+
+// (POST /games/gofish) : start a go fish game between the players specified in body
+func (h *handlers) GoFishStart(ctx echo.Context) error {
+	var body api.GoFishSetup
+	if err := ctx.Bind(&body); err != nil {
+		return Problem(ctx, err)
+	}
+
+	players, err := toGoFishPlayers(body.Players)
+	if err != nil {
+		return Problem(ctx, err)
+	}
+
+	h.lock.Lock()
+	defer h.lock.Unlock()
+
+	session, err := h.fetchMutableSession(ctx)
+	if err != nil {
+		return Problem(ctx, err)
+	}
+
+	g, err := gofish.New(session.Deck.Cards, players)
+	if err != nil {
+		return Problem(ctx, err)
+	}
+
+	if err := g.PlayBots(); err != nil {
+		return Problem(ctx, err)
+	}
+
+	// the cards stay out of the deck until the game is ended
+	session.Deck.Cards = nil
+	h.sessions.SetGame(session.Id, g)
+
+	viewer := gofish.NoTarget
+	for i, p := range g.Players {
+		if p.Bot == nil {
+			viewer = i
+			break
+		}
+	}
+
+	return JSON(ctx, http.StatusCreated, fromGoFishGame(g, viewer))
+}
+
+// (POST /games/gofish/asks) : ask another player for the cards of a value in the go fish game
+func (h *handlers) GoFishAsk(ctx echo.Context) error {
+	var body api.GoFishAsk
+	if err := ctx.Bind(&body); err != nil {
+		return Problem(ctx, err)
+	}
+
+	value, err := game.ParseValue(body.Value)
+	if err != nil {
+		return Problem(ctx, err)
+	}
+
+	h.lock.Lock()
+	defer h.lock.Unlock()
+
+	session, err := h.fetchPlayingSession(ctx)
+	if err != nil {
+		return Problem(ctx, err)
+	}
+
+	g, err := gofishGame(session)
+	if err != nil {
+		return Problem(ctx, err)
+	}
+
+	player, err := g.Find(string(body.Player))
+	if err != nil {
+		return Problem(ctx, err)
+	}
+
+	target, err := g.Find(string(body.Target))
+	if err != nil {
+		return Problem(ctx, err)
+	}
+
+	if g.Players[player].Bot != nil {
+		return Problem(ctx, fmt.Errorf("%w: %s is played by a bot", gofish.ErrMoveIllegal, body.Player))
+	}
+
+	if _, err := g.Ask(player, target, value); err != nil {
+		return Problem(ctx, err)
+	}
+
+	if err := g.PlayBots(); err != nil {
+		return Problem(ctx, err)
+	}
+
+	return JSON(ctx, http.StatusOK, fromGoFishGame(g, player))
+}
+
+// (DELETE /games/gofish) : end the go fish game & put the cards back into the deck
+func (h *handlers) GoFishEnd(ctx echo.Context) error {
+	format, err := negotiate(ctx)
+	if err != nil {
+		return Problem(ctx, err)
+	}
+
+	h.lock.Lock()
+	defer h.lock.Unlock()
+
+	session, err := h.fetchPlayingSession(ctx)
+	if err != nil {
+		return Problem(ctx, err)
+	}
+
+	g, err := gofishGame(session)
+	if err != nil {
+		return Problem(ctx, err)
+	}
+
+	session.Deck.Cards = append(session.Deck.Cards, g.Cards()...)
+	h.sessions.SetGame(session.Id, nil)
+
+	return Cards(ctx, http.StatusOK, format, session.Deck.Cards)
+}
+
+// gofishGame returns the go fish game being played in the session
+func gofishGame(session state.Session) (*gofish.Game, error) {
+	g, ok := session.Game.(*gofish.Game)
+	if !ok {
+		return nil, state.ErrGameNotFound
+	}
+
+	return g, nil
+}
+
+func toGoFishPlayers(seats []api.GoFishSeat) ([]gofish.Player, error) {
+	players := make([]gofish.Player, 0, len(seats))
+
+	for _, seat := range seats {
+		p := gofish.Player{Name: string(seat.Name)}
+
+		if seat.Bot != nil {
+			bot, err := gofish.ParseStrategy(*seat.Bot)
+			if err != nil {
+				return nil, fmt.Errorf("%w: the bot '%s' is unknown", gofish.ErrPlayersInvalid, *seat.Bot)
+			}
+
+			p.Bot = bot
+		}
+
+		players = append(players, p)
+	}
+
+	return players, nil
+}
+
+// fromGoFishGame shows the game as seen by the viewer: only the viewer's own hand is shown
+func fromGoFishGame(g *gofish.Game, viewer int) api.GoFishGame {
+	v := g.View(viewer)
+
+	result := api.GoFishGame{
+		Status:  api.GoFishGameStatus(v.Status.String()),
+		Stock:   v.Stock,
+		Players: make([]api.GoFishPlayer, 0, len(v.Players)),
+		Turns:   make([]api.GoFishTurn, 0),
+	}
+
+	name := func(i int) api.GoFishPlayerName {
+		return api.GoFishPlayerName(v.Players[i].Name)
+	}
+
+	for _, p := range v.Players {
+		player := api.GoFishPlayer{
+			Name:  api.GoFishPlayerName(p.Name),
+			Cards: p.Cards,
+			Books: fromGameValues(p.Books),
+		}
+
+		if p.Bot != nil {
+			bot := p.Bot.Name()
+			player.Bot = &bot
+		}
+
+		result.Players = append(result.Players, player)
+	}
+
+	if viewer != gofish.NoTarget {
+		hand := fromGameCards(v.Hand)
+		result.Hand = &hand
+	}
+
+	if v.Status == gofish.StatusInProgress {
+		turn := name(v.Current)
+		result.Turn = &turn
+	} else {
+		winners := make([]api.GoFishPlayerName, 0)
+		for _, w := range g.Winners() {
+			winners = append(winners, name(w))
+		}
+
+		result.Winners = &winners
+	}
+
+	for _, t := range recentTurns(v) {
+		turn := api.GoFishTurn{
+			Player: name(t.Player),
+			Got:    t.Got,
+			Drawn:  t.Drawn,
+			Again:  t.Again,
+			Books:  fromGameValues(t.Books),
+		}
+
+		if t.Target != gofish.NoTarget {
+			target, value := name(t.Target), t.Value.String()
+			turn.Target, turn.Value = &target, &value
+		}
+
+		result.Turns = append(result.Turns, turn)
+	}
+
+	return result
+}
+
+// recentTurns returns the turns since the viewer's last ask, or the last round of turns for a spectator
+func recentTurns(v gofish.View) []gofish.Turn {
+	if v.Player == gofish.NoTarget {
+		return v.Log[max(0, len(v.Log)-len(v.Players)):]
+	}
+
+	for i := len(v.Log) - 1; i >= 0; i-- {
+		if t := v.Log[i]; t.Player == v.Player && t.Target != gofish.NoTarget {
+			return v.Log[i:]
+		}
+	}
+
+	return v.Log
+}
+
+func fromGameValues(values []game.Value) []string {
+	result := make([]string, 0, len(values))
+	for _, v := range values {
+		result = append(result, v.String())
+	}
+
+	return result
+}
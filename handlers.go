@@ -1,13 +1,22 @@
 package main
 
 import (
+	"bytes"
 	_ "embed"
 	"fmt"
+	"io"
+	"io/ioutil"
 	"net/http"
+	"strings"
 	"sync"
 
 	"github.com/AntonAverchenkov/cards-http-service/internal/api"
 	"github.com/AntonAverchenkov/cards-http-service/internal/game"
+	"github.com/AntonAverchenkov/cards-http-service/internal/game/stats"
+	"github.com/AntonAverchenkov/cards-http-service/internal/limit"
+	"github.com/AntonAverchenkov/cards-http-service/internal/media"
+	"github.com/AntonAverchenkov/cards-http-service/internal/metrics"
+	"github.com/AntonAverchenkov/cards-http-service/internal/render"
 	"github.com/AntonAverchenkov/cards-http-service/internal/state"
 	"github.com/labstack/echo/v4"
 )
@@ -17,12 +26,15 @@ var documentation string
 
 const (
 	sessionCookie   = "session"
+	sessionHeader   = "X-Session-Id"
 	sessionLifetime = 3600
 )
 
 type handlers struct {
 	lock     *sync.Mutex
 	sessions *state.SessionManager
+	limits   *limit.Limits
+	health   *health
 }
 
 // (GET /) : get documentation index.html that describes this api
@@ -32,43 +44,267 @@ func (h *handlers) Index(ctx echo.Context) error {
 
 // (GET /cards) : get the current state of the deck
 func (h *handlers) DeckShow(ctx echo.Context) error {
+	format, err := negotiate(ctx)
+	if err != nil {
+		return Problem(ctx, err)
+	}
+
 	h.lock.Lock()
 	defer h.lock.Unlock()
 
-	session := h.fetchSessionSetCookie(ctx)
+	session, err := h.fetchSessionSetCookie(ctx)
+	if err != nil {
+		return Problem(ctx, err)
+	}
 
-	return JSON(ctx, http.StatusOK, fromGameCards(session.Deck.Cards))
+	return Cards(ctx, http.StatusOK, format, session.Deck.Cards)
 }
 
-// (POST /cards/shuffle) : permute the deck in an unbiased way
-func (h *handlers) DeckShuffle(ctx echo.Context) error {
+// (GET /cards/stats) : count the remaining cards by their suits and values
+func (h *handlers) DeckStats(ctx echo.Context) error {
 	h.lock.Lock()
 	defer h.lock.Unlock()
 
-	session := h.fetchSessionSetCookie(ctx)
-	session.Deck.Shuffle()
+	session, err := h.fetchSessionSetCookie(ctx)
+	if err != nil {
+		return Problem(ctx, err)
+	}
+
+	summary := stats.Summarize(session.Deck.Cards)
+
+	result := api.DeckStats{
+		Remaining: summary.Remaining,
+		Blackjack: api.BlackjackPoints{
+			Low:  summary.BlackjackLow,
+			High: summary.BlackjackHigh,
+		},
+		Suits:  api.DeckStats_Suits{AdditionalProperties: make(map[string]int, len(summary.Suits))},
+		Values: api.DeckStats_Values{AdditionalProperties: make(map[string]int, len(summary.Values))},
+	}
 
-	return JSON(ctx, http.StatusOK, fromGameCards(session.Deck.Cards))
+	for suit, count := range summary.Suits {
+		result.Suits.AdditionalProperties[game.Suit(suit).String()] = count
+	}
+	for value, count := range summary.Values {
+		result.Values.AdditionalProperties[game.Value(value).String()] = count
+	}
+
+	return JSON(ctx, http.StatusOK, result)
 }
 
-// (GET /cards/shuffle) : permute the deck in an unbiased way (in-browser testing helper)
-func (h *handlers) DeckShuffle2(ctx echo.Context) error {
-	return h.DeckShuffle(ctx)
+// (GET /cards/odds?event={event}) : get the chance of drawing at least the given number of the cards of a kind in the next cards
+func (h *handlers) DeckOdds(ctx echo.Context, params api.DeckOddsParams) error {
+	event, err := stats.ParseEvent(params.Event)
+	if err != nil {
+		return Problem(ctx, err)
+	}
+
+	draws, atLeast := 1, 1
+
+	if params.Draws != nil {
+		draws = *params.Draws
+	}
+	if params.AtLeast != nil {
+		atLeast = *params.AtLeast
+	}
+
+	h.lock.Lock()
+	defer h.lock.Unlock()
+
+	session, err := h.fetchSessionSetCookie(ctx)
+	if err != nil {
+		return Problem(ctx, err)
+	}
+
+	odds, err := stats.AtLeast(session.Deck.Cards, event, atLeast, draws)
+	if err != nil {
+		return Problem(ctx, err)
+	}
+
+	return JSON(ctx, http.StatusOK, api.DeckOdds{
+		Event:       odds.Event.String(),
+		Draws:       odds.Draws,
+		AtLeast:     odds.AtLeast,
+		Matching:    odds.Matching,
+		Remaining:   odds.Remaining,
+		Probability: odds.Probability,
+	})
+}
+
+// (GET /cards.svg) : render the current state of the deck as an svg image
+func (h *handlers) DeckRenderSvg(ctx echo.Context, params api.DeckRenderSvgParams) error {
+	h.lock.Lock()
+	defer h.lock.Unlock()
+
+	session, err := h.fetchSessionSetCookie(ctx)
+	if err != nil {
+		return Problem(ctx, err)
+	}
+
+	opts := render.Options{
+		Fan:      params.Fan != nil && bool(*params.Fan),
+		FaceDown: params.FaceDown != nil && bool(*params.FaceDown),
+	}
+
+	return SVG(ctx, http.StatusOK, func(w io.Writer) error {
+		return render.Hand(w, session.Deck.Cards, opts)
+	})
+}
+
+// (GET /card/{card}.svg) : render a single card as an svg image
+func (h *handlers) CardRenderSvg(ctx echo.Context, card string, params api.CardRenderSvgParams) error {
+	c, err := game.ParseCard(strings.TrimSuffix(card, ".svg"))
+	if err != nil {
+		return Problem(ctx, err)
+	}
+
+	if params.FaceDown != nil && bool(*params.FaceDown) {
+		return SVG(ctx, http.StatusOK, render.Back)
+	}
+
+	return SVG(ctx, http.StatusOK, func(w io.Writer) error {
+		return render.Card(w, c)
+	})
+}
+
+// (POST /cards/shuffle) : permute the deck in an unbiased way or with a model of a human shuffle
+func (h *handlers) DeckShuffle(ctx echo.Context, params api.DeckShuffleParams) error {
+	return h.shuffle(ctx, (*string)(params.Method), (*int)(params.Times))
+}
+
+// (GET /cards/shuffle) : permute the deck in an unbiased way or with a model of a human shuffle (in-browser testing helper)
+func (h *handlers) DeckShuffle2(ctx echo.Context, params api.DeckShuffle2Params) error {
+	return h.shuffle(ctx, (*string)(params.Method), (*int)(params.Times))
+}
+
+func (h *handlers) shuffle(ctx echo.Context, method *string, times *int) error {
+	format, err := negotiate(ctx)
+	if err != nil {
+		return Problem(ctx, err)
+	}
+
+	m, n := game.ShuffleFisherYates, 1
+
+	if method != nil {
+		if m, err = game.ParseShuffleMethod(*method); err != nil {
+			return Problem(ctx, err)
+		}
+	}
+	if times != nil {
+		n = *times
+	}
+
+	h.lock.Lock()
+	defer h.lock.Unlock()
+
+	session, err := h.fetchMutableSession(ctx)
+	if err != nil {
+		return Problem(ctx, err)
+	}
+
+	session.Deck.ShuffleWith(m, n)
+
+	return Cards(ctx, http.StatusOK, format, session.Deck.Cards)
+}
+
+// (POST /cards/sort) : sort the deck from the lowest (on top) to the highest card in the given order
+func (h *handlers) DeckSort(ctx echo.Context, params api.DeckSortParams) error {
+	format, err := negotiate(ctx)
+	if err != nil {
+		return Problem(ctx, err)
+	}
+
+	order := game.OrderNewDeck
+
+	if params.Order != nil {
+		if order, err = game.ParseCardOrder(string(*params.Order)); err != nil {
+			return Problem(ctx, err)
+		}
+	}
+
+	h.lock.Lock()
+	defer h.lock.Unlock()
+
+	session, err := h.fetchMutableSession(ctx)
+	if err != nil {
+		return Problem(ctx, err)
+	}
+
+	session.Deck.Sort(order)
+
+	return Cards(ctx, http.StatusOK, format, session.Deck.Cards)
+}
+
+// (POST /cards/cut) : move the top cards to the bottom of the deck
+func (h *handlers) DeckCut(ctx echo.Context, params api.DeckCutParams) error {
+	format, err := negotiate(ctx)
+	if err != nil {
+		return Problem(ctx, err)
+	}
+
+	h.lock.Lock()
+	defer h.lock.Unlock()
+
+	session, err := h.fetchMutableSession(ctx)
+	if err != nil {
+		return Problem(ctx, err)
+	}
+
+	if err := session.Deck.Cut(params.Count); err != nil {
+		return Problem(ctx, err)
+	}
+
+	return Cards(ctx, http.StatusOK, format, session.Deck.Cards)
+}
+
+// (GET /cards/peek) : get the top cards without removing them from the deck
+func (h *handlers) DeckPeek(ctx echo.Context, params api.DeckPeekParams) error {
+	format, err := negotiate(ctx)
+	if err != nil {
+		return Problem(ctx, err)
+	}
+
+	count := 1
+
+	if params.Count != nil {
+		count = *params.Count
+	}
+
+	h.lock.Lock()
+	defer h.lock.Unlock()
+
+	session, err := h.fetchSessionSetCookie(ctx)
+	if err != nil {
+		return Problem(ctx, err)
+	}
+
+	return Cards(ctx, http.StatusOK, format, session.Deck.Peek(count))
 }
 
 // (POST /cards/deal) : deal the top card by removing it from the deck
 func (h *handlers) DeckDealCard(ctx echo.Context) error {
+	format, err := negotiate(ctx)
+	if err != nil {
+		return Problem(ctx, err)
+	}
+
 	h.lock.Lock()
 	defer h.lock.Unlock()
 
-	session := h.fetchSessionSetCookie(ctx)
+	session, err := h.fetchMutableSession(ctx)
+	if err != nil {
+		return Problem(ctx, err)
+	}
 
 	card, err := session.Deck.DealCard()
 	if err != nil {
-		return JSON(ctx, http.StatusConflict, api.Error{Message: err.Error()})
+		return Problem(ctx, err)
 	}
 
-	return JSON(ctx, http.StatusOK, fromGameCard(card))
+	metrics.CardDealt()
+	ctx.Set(cardKey, card)
+
+	return Card(ctx, http.StatusOK, format, card)
 }
 
 // (GET /cards/deal) : deal the top card by removing it from the deck (in-browser testing helper)
@@ -76,61 +312,311 @@ func (h *handlers) DeckDealCard2(ctx echo.Context) error {
 	return h.DeckDealCard(ctx)
 }
 
+// (POST /cards/deal/bottom) : deal the bottom card by removing it from the deck
+func (h *handlers) DeckDealBottom(ctx echo.Context) error {
+	format, err := negotiate(ctx)
+	if err != nil {
+		return Problem(ctx, err)
+	}
+
+	h.lock.Lock()
+	defer h.lock.Unlock()
+
+	session, err := h.fetchMutableSession(ctx)
+	if err != nil {
+		return Problem(ctx, err)
+	}
+
+	card, err := session.Deck.DealBottom()
+	if err != nil {
+		return Problem(ctx, err)
+	}
+
+	metrics.CardDealt()
+	ctx.Set(cardKey, card)
+
+	return Card(ctx, http.StatusOK, format, card)
+}
+
+// (POST /cards/deal/at?index={index}) : deal the card at the given position by removing it from the deck
+func (h *handlers) DeckDealAt(ctx echo.Context, params api.DeckDealAtParams) error {
+	format, err := negotiate(ctx)
+	if err != nil {
+		return Problem(ctx, err)
+	}
+
+	h.lock.Lock()
+	defer h.lock.Unlock()
+
+	session, err := h.fetchMutableSession(ctx)
+	if err != nil {
+		return Problem(ctx, err)
+	}
+
+	card, err := session.Deck.DealAt(int(params.Index))
+	if err != nil {
+		return Problem(ctx, err)
+	}
+
+	metrics.CardDealt()
+	ctx.Set(cardKey, card)
+
+	return Card(ctx, http.StatusOK, format, card)
+}
+
 // (POST /cards/return) : return the card specified in body to the back of the deck
 func (h *handlers) DeckReturnCard(ctx echo.Context) error {
-	// We expect an api.Card object in the request body
-	var c api.Card
-	err := ctx.Bind(&c)
+	format, err := negotiate(ctx)
 	if err != nil {
-		return JSON(ctx, http.StatusBadRequest, api.Error{Message: err.Error()})
+		return Problem(ctx, err)
 	}
 
-	card, err := toGameCard(c)
+	card, err := bindCard(ctx)
 	if err != nil {
-		return JSON(ctx, http.StatusBadRequest, api.Error{Message: err.Error()})
+		return Problem(ctx, err)
 	}
 
+	ctx.Set(cardKey, card)
+
 	h.lock.Lock()
 	defer h.lock.Unlock()
 
-	session := h.fetchSessionSetCookie(ctx)
+	session, err := h.fetchMutableSession(ctx)
+	if err != nil {
+		return Problem(ctx, err)
+	}
 
 	err = session.Deck.ReturnCard(card)
 	if err != nil {
-		return JSON(ctx, http.StatusConflict, api.Error{Message: err.Error()})
+		return Problem(ctx, err)
 	}
 
-	return JSON(ctx, http.StatusOK, fromGameCards(session.Deck.Cards))
+	metrics.CardReturned()
+
+	return Cards(ctx, http.StatusOK, format, session.Deck.Cards)
 }
 
 // (GET /cards/return?card={card}) : return the card specified in the '?card=' parameter to the back of the deck (in-browser testing helper)
 func (h *handlers) DeckReturnCard2(ctx echo.Context, params api.DeckReturnCard2Params) error {
+	format, err := negotiate(ctx)
+	if err != nil {
+		return Problem(ctx, err)
+	}
+
 	if params.Card == nil {
-		return JSON(ctx, http.StatusBadRequest, api.Error{Message: "the required url parameter 'card' is missing"})
+		return Problem(ctx, echo.NewHTTPError(http.StatusBadRequest, "the required url parameter 'card' is missing"))
 	}
 
 	card, err := game.ParseCard(*params.Card)
 	if err != nil {
-		return JSON(ctx, http.StatusBadRequest, api.Error{Message: err.Error()})
+		return Problem(ctx, err)
 	}
 
+	ctx.Set(cardKey, card)
+
 	h.lock.Lock()
 	defer h.lock.Unlock()
 
-	session := h.fetchSessionSetCookie(ctx)
+	session, err := h.fetchMutableSession(ctx)
+	if err != nil {
+		return Problem(ctx, err)
+	}
 
 	err = session.Deck.ReturnCard(card)
 	if err != nil {
-		return JSON(ctx, http.StatusConflict, api.Error{Message: err.Error()})
+		return Problem(ctx, err)
 	}
 
-	return JSON(ctx, http.StatusOK, fromGameCards(session.Deck.Cards))
+	metrics.CardReturned()
+
+	return Cards(ctx, http.StatusOK, format, session.Deck.Cards)
+}
+
+// (POST /cards/draw) : remove the card specified in body from wherever it is in the deck
+func (h *handlers) DeckDrawCard(ctx echo.Context) error {
+	format, err := negotiate(ctx)
+	if err != nil {
+		return Problem(ctx, err)
+	}
+
+	card, err := bindCard(ctx)
+	if err != nil {
+		return Problem(ctx, err)
+	}
+
+	ctx.Set(cardKey, card)
+
+	h.lock.Lock()
+	defer h.lock.Unlock()
+
+	session, err := h.fetchMutableSession(ctx)
+	if err != nil {
+		return Problem(ctx, err)
+	}
+
+	if err := session.Deck.Draw(card); err != nil {
+		return Problem(ctx, err)
+	}
+
+	metrics.CardDealt()
+
+	return Cards(ctx, http.StatusOK, format, session.Deck.Cards)
+}
+
+// (POST /cards/insert?index={index}) : insert the card specified in body at the given position in the deck
+func (h *handlers) DeckInsertCard(ctx echo.Context, params api.DeckInsertCardParams) error {
+	format, err := negotiate(ctx)
+	if err != nil {
+		return Problem(ctx, err)
+	}
+
+	card, err := bindCard(ctx)
+	if err != nil {
+		return Problem(ctx, err)
+	}
+
+	ctx.Set(cardKey, card)
+
+	h.lock.Lock()
+	defer h.lock.Unlock()
+
+	session, err := h.fetchMutableSession(ctx)
+	if err != nil {
+		return Problem(ctx, err)
+	}
+
+	if err := session.Deck.InsertAt(card, int(params.Index)); err != nil {
+		return Problem(ctx, err)
+	}
+
+	metrics.CardReturned()
+
+	return Cards(ctx, http.StatusOK, format, session.Deck.Cards)
+}
+
+// (POST /cards/reset) : replace the deck with a new one in the given order
+func (h *handlers) DeckReset(ctx echo.Context, params api.DeckResetParams) error {
+	return h.reset(ctx, (*string)(params.Order), (*string)(params.Cards), (*string)(params.Composition))
+}
+
+// (GET /cards/reset) : replace the deck with a new one in the given order (in-browser testing helper)
+func (h *handlers) DeckReset2(ctx echo.Context, params api.DeckReset2Params) error {
+	return h.reset(ctx, (*string)(params.Order), (*string)(params.Cards), (*string)(params.Composition))
+}
+
+func (h *handlers) reset(ctx echo.Context, order, cards, composition *string) error {
+	format, err := negotiate(ctx)
+	if err != nil {
+		return Problem(ctx, err)
+	}
+
+	deck, err := newDeck(order, cards, composition)
+	if err != nil {
+		return Problem(ctx, err)
+	}
+
+	h.lock.Lock()
+	defer h.lock.Unlock()
+
+	session, err := h.fetchMutableSession(ctx)
+	if err != nil {
+		return Problem(ctx, err)
+	}
+
+	*session.Deck = *deck
+
+	return Cards(ctx, http.StatusOK, format, session.Deck.Cards)
+}
+
+// newDeck builds the deck described by the reset parameters (which have been validated against the spec)
+func newDeck(order, cards, composition *string) (*game.Deck, error) {
+	c := game.CompositionStandard
+
+	if composition != nil {
+		var err error
+		if c, err = game.ParseComposition(*composition); err != nil {
+			return nil, err
+		}
+	}
+
+	deck := game.NewDeck(game.WithComposition(c))
+
+	if order == nil || *order != string(api.Given) {
+		if cards != nil {
+			return nil, echo.NewHTTPError(http.StatusBadRequest, "the url parameter 'cards' requires order=given")
+		}
+
+		if order != nil && *order == string(api.Shuffled) {
+			deck.Shuffle()
+		}
+
+		return deck, nil
+	}
+
+	if cards == nil {
+		return nil, echo.NewHTTPError(http.StatusBadRequest, "the url parameter 'cards' is required by order=given")
+	}
+
+	given, err := game.DeckDeserialize(*cards)
+	if err != nil {
+		return nil, &game.OrderError{Reason: err.Error()}
+	}
+
+	if err := deck.Arrange(given.Cards); err != nil {
+		return nil, err
+	}
+
+	return deck, nil
 }
 
 // will fetch or create a new session, setting the session cookie if needed
-func (h *handlers) fetchSessionSetCookie(ctx echo.Context) state.Session {
+func (h *handlers) fetchSessionSetCookie(ctx echo.Context) (state.Session, error) {
+	session, err := h.fetchOrCreateSession(ctx)
+	if err != nil {
+		return state.Session{}, err
+	}
+
+	// the session is logged alongside the request
+	ctx.Set(sessionKey, session.Id)
+
+	return session, nil
+}
+
+// will fetch a session like fetchPlayingSession & make sure that the deck's cards are not in a game
+func (h *handlers) fetchMutableSession(ctx echo.Context) (state.Session, error) {
+	session, err := h.fetchPlayingSession(ctx)
+	if err != nil {
+		return state.Session{}, err
+	}
+
+	if session.Game != nil {
+		return state.Session{}, state.ErrGameInProgress
+	}
+
+	return session, nil
+}
+
+// will fetch or create a new session like fetchSessionSetCookie & charge the deck mutation budgets
+func (h *handlers) fetchPlayingSession(ctx echo.Context) (state.Session, error) {
+	session, err := h.fetchSessionSetCookie(ctx)
+	if err != nil {
+		return state.Session{}, err
+	}
+
+	if err := h.limits.Mutate(session.Id, ctx.RealIP()); err != nil {
+		return state.Session{}, err
+	}
+
+	return session, nil
+}
+
+func (h *handlers) fetchOrCreateSession(ctx echo.Context) (state.Session, error) {
+
+	createSessionSetCookie := func(ctx echo.Context) (state.Session, error) {
+		if err := h.limits.CreateSession(ctx.RealIP()); err != nil {
+			return state.Session{}, err
+		}
 
-	createSessionSetCookie := func(ctx echo.Context) state.Session {
 		session := h.sessions.CreateSession()
 
 		cookie := http.Cookie{
@@ -141,9 +627,32 @@ func (h *handlers) fetchSessionSetCookie(ctx echo.Context) state.Session {
 			MaxAge:   sessionLifetime,
 		}
 
+		// the session id must not leak over plain http once it was issued over https
+		if ctx.IsTLS() {
+			cookie.Secure = true
+			cookie.SameSite = http.SameSiteLaxMode
+		}
+
 		ctx.SetCookie(&cookie)
 
-		return session
+		// clients that do not keep cookies could send the id back in the header instead
+		ctx.Response().Header().Set(sessionHeader, session.Id)
+
+		return session, nil
+	}
+
+	// the unknown ids (e.g. the forged ones or the ones lost on a restart) get fresh sessions rather than being adopted
+	fetchSession := func(ctx echo.Context, id string) (state.Session, error) {
+		if session, exists := h.sessions.GetSession(id); exists {
+			return session, nil
+		}
+
+		return createSessionSetCookie(ctx)
+	}
+
+	// the header takes precedence over the cookie
+	if id := ctx.Request().Header.Get(sessionHeader); id != "" {
+		return fetchSession(ctx, id)
 	}
 
 	// check if the cookie already exists
@@ -153,9 +662,67 @@ func (h *handlers) fetchSessionSetCookie(ctx echo.Context) state.Session {
 		return createSessionSetCookie(ctx)
 	}
 
-	session := h.sessions.GetOrCreateSession(cookie.Value)
+	return fetchSession(ctx, cookie.Value)
+}
+
+// bindCard parses the card in the request body from any of the supported representations
+func bindCard(ctx echo.Context) (game.Card, error) {
+	format, err := media.ParseContentType(ctx.Request().Header.Get(echo.HeaderContentType))
+	if err != nil {
+		return game.Card{}, err
+	}
+
+	if format == media.FormatJSON {
+		// We expect an api.Card object in the request body
+		var c api.Card
+		if err := ctx.Bind(&c); err != nil {
+			return game.Card{}, err
+		}
+
+		return toGameCard(c)
+	}
+
+	body, err := ioutil.ReadAll(ctx.Request().Body)
+	if err != nil {
+		return game.Card{}, err
+	}
+
+	return media.DecodeCard(format, body)
+}
+
+// negotiate picks the representation of the cards through the 'Accept' header; the handlers call it before
+// changing the deck, so that a request for an unknown representation leaves the deck untouched
+func negotiate(ctx echo.Context) (media.Format, error) {
+	return media.Negotiate(ctx.Request().Header.Get(echo.HeaderAccept))
+}
+
+// Cards writes the cards in the representation negotiated by negotiate
+func Cards(ctx echo.Context, code int, format media.Format, cards []game.Card) error {
+	if format == media.FormatJSON {
+		return JSON(ctx, code, fromGameCards(cards))
+	}
+
+	return ctx.Blob(code, format.ContentType(), media.EncodeCards(format, cards))
+}
+
+// Card writes a single card in the representation negotiated by negotiate
+func Card(ctx echo.Context, code int, format media.Format, card game.Card) error {
+	if format == media.FormatJSON {
+		return JSON(ctx, code, fromGameCard(card))
+	}
+
+	return ctx.Blob(code, format.ContentType(), media.EncodeCard(format, card))
+}
+
+// SVG is a rendering helper
+func SVG(ctx echo.Context, code int, render func(w io.Writer) error) error {
+	var b bytes.Buffer
+
+	if err := render(&b); err != nil {
+		return Problem(ctx, err)
+	}
 
-	return session
+	return ctx.Blob(code, "image/svg+xml", b.Bytes())
 }
 
 // JSON is a formatting helper
@@ -175,7 +742,7 @@ func fromGameCard(card game.Card) api.Card {
 }
 
 func fromGameCards(cards []game.Card) []api.Card {
-	var result []api.Card
+	result := make([]api.Card, 0, len(cards))
 
 	for _, card := range cards {
 		result = append(result, fromGameCard(card))
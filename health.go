@@ -0,0 +1,62 @@
+package main
+
+import (
+	"net/http"
+	"runtime/debug"
+	"sync/atomic"
+
+	"github.com/AntonAverchenkov/cards-http-service/internal/api"
+	"github.com/labstack/echo/v4"
+)
+
+// health tracks the readiness of the service & describes how it was built
+type health struct {
+	ready atomic.Bool
+	store api.VersionStore
+}
+
+// (GET /healthz) : check that the service is alive
+func (h *handlers) Healthz(ctx echo.Context) error {
+	return ctx.JSON(http.StatusOK, api.Health{Status: api.HealthStatusOk})
+}
+
+// (GET /readyz) : check that the service is ready to serve the traffic
+func (h *handlers) Readyz(ctx echo.Context) error {
+	if !h.health.ready.Load() {
+		return ctx.JSON(http.StatusServiceUnavailable, api.Health{Status: api.HealthStatusUnavailable})
+	}
+
+	return ctx.JSON(http.StatusOK, api.Health{Status: api.HealthStatusOk})
+}
+
+// (GET /version) : get the build information of the running service
+func (h *handlers) Version(ctx echo.Context) error {
+	return ctx.JSON(http.StatusOK, buildVersion(h.health.store))
+}
+
+// buildVersion reads the module version & the vcs stamps embedded by the go toolchain
+func buildVersion(store api.VersionStore) api.Version {
+	version := api.Version{
+		Version: "unknown",
+		Store:   store,
+	}
+
+	info, ok := debug.ReadBuildInfo()
+	if !ok {
+		return version
+	}
+
+	version.Version = info.Main.Version
+
+	for _, setting := range info.Settings {
+		switch setting.Key {
+		case "vcs.revision":
+			version.Revision = setting.Value
+		case "vcs.modified":
+			modified := setting.Value == "true"
+			version.Modified = &modified
+		}
+	}
+
+	return version
+}
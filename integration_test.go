@@ -9,14 +9,15 @@ package main
 //
 
 import (
-	"encoding/json"
-	"io/ioutil"
+	"bytes"
+	"context"
 	"log"
 	"net/http"
 	"os"
+	"strings"
 	"testing"
 
-	"github.com/AntonAverchenkov/cards-http-service/internal/api"
+	"github.com/AntonAverchenkov/cards-http-service/client"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
@@ -25,10 +26,7 @@ import (
 type IntegrationTestSuite struct {
 	suite.Suite
 
-	endpointCards        string
-	endpointCardsShuffle string
-	endpointCardsDeal    string
-	endpointCardsReturn  string
+	endpoint string
 }
 
 func TestIntegrationTestSuite(t *testing.T) {
@@ -45,47 +43,32 @@ func (suite *IntegrationTestSuite) SetupSuite() {
 
 	var found bool
 
-	suite.endpointCards, found = os.LookupEnv("ENDPOINT_CARDS")
-	require.True(suite.T(), found)
-
-	suite.endpointCardsShuffle, found = os.LookupEnv("ENDPOINT_CARDS_SHUFFLE")
+	suite.endpoint, found = os.LookupEnv("ENDPOINT")
 	require.True(suite.T(), found)
+}
 
-	suite.endpointCardsDeal, found = os.LookupEnv("ENDPOINT_CARDS_DEAL")
-	require.True(suite.T(), found)
+// newSession returns a client with a fresh session
+func (suite *IntegrationTestSuite) newSession(opts ...client.SessionOption) *client.Session {
+	session, err := client.NewSession(suite.endpoint, opts...)
+	require.NoError(suite.T(), err)
 
-	suite.endpointCardsReturn, found = os.LookupEnv("ENDPOINT_CARDS_RETURN")
-	require.True(suite.T(), found)
+	return session
 }
 
 func (suite *IntegrationTestSuite) TestCardsEndpoint() {
 	/* */ log.Println("IntegrationTestSuite::TestCardsEndpoint : begin")
 	defer log.Println("IntegrationTestSuite::TestCardsEndpoint : end")
 
-	request, err := http.NewRequest("GET", suite.endpointCards, nil)
-	require.NoError(suite.T(), err)
-
-	response, err := http.DefaultClient.Do(request)
-	require.NoError(suite.T(), err)
-	defer response.Body.Close()
-
-	require.Equal(suite.T(), 200, response.StatusCode)
-	require.Len(suite.T(), response.Cookies(), 1)
-	assert.Equal(suite.T(), sessionCookie, response.Cookies()[0].Name)
-
-	// parse the response
-	var cards []api.Card
-
-	reponseBytes, err := ioutil.ReadAll(response.Body)
-	require.NoError(suite.T(), err)
+	session := suite.newSession()
 
-	err = json.Unmarshal(reponseBytes, &cards)
+	cards, err := session.Show(context.Background())
 	require.NoError(suite.T(), err)
+	assert.NotEmpty(suite.T(), session.ID())
 
 	require.Len(suite.T(), cards, 52)
 	assert.Equal(
 		suite.T(),
-		[]api.Card{
+		[]client.Card{
 			{Value: "ace", Suit: "clubs"},
 			{Value: "two", Suit: "clubs"},
 			{Value: "three", Suit: "clubs"},
@@ -100,31 +83,17 @@ func (suite *IntegrationTestSuite) TestCardsShuffleEndpoint() {
 	/* */ log.Println("IntegrationTestSuite::TestCardsShuffleEndpoint : begin")
 	defer log.Println("IntegrationTestSuite::TestCardsShuffleEndpoint : end")
 
-	request, err := http.NewRequest("POST", suite.endpointCardsShuffle, nil)
-	require.NoError(suite.T(), err)
-
-	response, err := http.DefaultClient.Do(request)
-	require.NoError(suite.T(), err)
-	defer response.Body.Close()
-
-	require.Equal(suite.T(), 200, response.StatusCode)
-	require.Len(suite.T(), response.Cookies(), 1)
-	assert.Equal(suite.T(), sessionCookie, response.Cookies()[0].Name)
-
-	// parse the response
-	var cards []api.Card
-
-	reponseBytes, err := ioutil.ReadAll(response.Body)
-	require.NoError(suite.T(), err)
+	session := suite.newSession()
 
-	err = json.Unmarshal(reponseBytes, &cards)
+	cards, err := session.Shuffle(context.Background())
 	require.NoError(suite.T(), err)
+	assert.NotEmpty(suite.T(), session.ID())
 
 	require.Len(suite.T(), cards, 52)
 	// it is highly unlikely for the random shuffle to result in the following:
 	assert.NotEqual(
 		suite.T(),
-		[]api.Card{
+		[]client.Card{
 			{Value: "ace", Suit: "clubs"},
 			{Value: "two", Suit: "clubs"},
 			{Value: "three", Suit: "clubs"},
@@ -133,4 +102,488 @@ func (suite *IntegrationTestSuite) TestCardsShuffleEndpoint() {
 		},
 		cards[0:5],
 	)
+
+	// the shuffled order is kept by the session
+	shown, err := session.Show(context.Background())
+	require.NoError(suite.T(), err)
+	assert.Equal(suite.T(), cards, shown)
+
+	// the deck is sorted back into the new deck order by default
+	sorted, err := session.Sort(context.Background(), nil)
+	require.NoError(suite.T(), err)
+	assert.Equal(suite.T(), client.Card{Value: "ace", Suit: "clubs"}, sorted[0])
+
+	order := client.DeckSortParamsOrder(client.Bridge)
+
+	sorted, err = session.Sort(context.Background(), &client.DeckSortParams{Order: &order})
+	require.NoError(suite.T(), err)
+	assert.Equal(suite.T(), client.Card{Value: "two", Suit: "clubs"}, sorted[0])
+	assert.Equal(suite.T(), client.Card{Value: "ace", Suit: "spades"}, sorted[51])
+
+	cards = sorted
+
+	// 8 perfect out-faros restore the deck
+	method, times := client.DeckShuffleParamsMethod(client.FaroOut), client.Times(8)
+
+	faro, err := session.ShuffleWith(context.Background(), &client.DeckShuffleParams{Method: &method, Times: &times})
+	require.NoError(suite.T(), err)
+	assert.Equal(suite.T(), cards, faro)
+}
+
+func (suite *IntegrationTestSuite) TestCardsDealReturnEndpoints() {
+	/* */ log.Println("IntegrationTestSuite::TestCardsDealReturnEndpoints : begin")
+	defer log.Println("IntegrationTestSuite::TestCardsDealReturnEndpoints : end")
+
+	// the session id is carried in the header rather than the cookie
+	session := suite.newSession(client.WithSessionTransport(client.SessionTransportHeader))
+	ctx := context.Background()
+
+	// the deck is full
+	_, err := session.Return(ctx, client.Card{Value: "ace", Suit: "clubs"})
+	assert.ErrorIs(suite.T(), err, client.ErrDeckFull)
+
+	card, err := session.Deal(ctx)
+	require.NoError(suite.T(), err)
+	assert.Equal(suite.T(), client.Card{Value: "ace", Suit: "clubs"}, card)
+
+	_, err = session.Deal(ctx)
+	require.NoError(suite.T(), err)
+
+	cards, err := session.Return(ctx, card)
+	require.NoError(suite.T(), err)
+	require.Len(suite.T(), cards, 51)
+	assert.Equal(suite.T(), card, cards[50])
+
+	// the card is already in the deck
+	_, err = session.Return(ctx, card)
+	assert.ErrorIs(suite.T(), err, client.ErrCardDuplicate)
+
+	// the card cannot be parsed
+	_, err = session.Return(ctx, client.Card{Value: "eleven", Suit: "clubs"})
+	assert.ErrorIs(suite.T(), err, client.ErrCardUnparseable)
+
+	// a representation which cannot be negotiated leaves the deck untouched
+	accept := func(ctx context.Context, req *http.Request) error {
+		req.Header.Set("Accept", "image/png")
+		return nil
+	}
+
+	dealt, err := session.API().DeckDealCardWithResponse(ctx, accept)
+	require.NoError(suite.T(), err)
+	assert.Equal(suite.T(), http.StatusNotAcceptable, dealt.StatusCode())
+
+	cards, err = session.Show(ctx)
+	require.NoError(suite.T(), err)
+	assert.Len(suite.T(), cards, 51)
+
+	// deal the remaining cards
+	for i := 0; i < 51; i++ {
+		_, err := session.Deal(ctx)
+		require.NoError(suite.T(), err)
+	}
+
+	_, err = session.Deal(ctx)
+	assert.ErrorIs(suite.T(), err, client.ErrDeckEmpty)
+}
+
+func (suite *IntegrationTestSuite) TestCardsManipulationEndpoints() {
+	/* */ log.Println("IntegrationTestSuite::TestCardsManipulationEndpoints : begin")
+	defer log.Println("IntegrationTestSuite::TestCardsManipulationEndpoints : end")
+
+	session := suite.newSession()
+	api := session.API()
+	ctx := context.Background()
+
+	peek, err := api.DeckPeekWithResponse(ctx, &client.DeckPeekParams{})
+	require.NoError(suite.T(), err)
+	require.NotNil(suite.T(), peek.JSON200)
+	assert.Equal(suite.T(), []client.Card{{Value: "ace", Suit: "clubs"}}, *peek.JSON200)
+
+	// the clubs go to the bottom
+	cut, err := api.DeckCutWithResponse(ctx, &client.DeckCutParams{Count: 13})
+	require.NoError(suite.T(), err)
+	require.NotNil(suite.T(), cut.JSON200)
+	assert.Equal(suite.T(), client.Card{Value: "ace", Suit: "hearts"}, (*cut.JSON200)[0])
+
+	bottom, err := api.DeckDealBottomWithResponse(ctx)
+	require.NoError(suite.T(), err)
+	require.NotNil(suite.T(), bottom.JSON200)
+	assert.Equal(suite.T(), client.Card{Value: "king", Suit: "clubs"}, *bottom.JSON200)
+
+	at, err := api.DeckDealAtWithResponse(ctx, &client.DeckDealAtParams{Index: 1})
+	require.NoError(suite.T(), err)
+	require.NotNil(suite.T(), at.JSON200)
+	assert.Equal(suite.T(), client.Card{Value: "two", Suit: "hearts"}, *at.JSON200)
+
+	// the king of clubs goes on top
+	insert, err := api.DeckInsertCardWithResponse(ctx, &client.DeckInsertCardParams{Index: 0}, client.DeckInsertCardJSONRequestBody(*bottom.JSON200))
+	require.NoError(suite.T(), err)
+	require.NotNil(suite.T(), insert.JSON200)
+	require.Len(suite.T(), *insert.JSON200, 51)
+	assert.Equal(suite.T(), *bottom.JSON200, (*insert.JSON200)[0])
+
+	draw, err := api.DeckDrawCardWithResponse(ctx, client.DeckDrawCardJSONRequestBody{Value: "queen", Suit: "spades"})
+	require.NoError(suite.T(), err)
+	require.NotNil(suite.T(), draw.JSON200)
+	assert.Len(suite.T(), *draw.JSON200, 50)
+
+	// the conflicts
+	_, err = session.Return(ctx, client.Card{Value: "queen", Suit: "spades"})
+	require.NoError(suite.T(), err)
+
+	draw, err = api.DeckDrawCardWithResponse(ctx, client.DeckDrawCardJSONRequestBody(*at.JSON200))
+	require.NoError(suite.T(), err)
+	assert.Equal(suite.T(), http.StatusConflict, draw.StatusCode())
+
+	at, err = api.DeckDealAtWithResponse(ctx, &client.DeckDealAtParams{Index: 51})
+	require.NoError(suite.T(), err)
+	assert.Equal(suite.T(), http.StatusConflict, at.StatusCode())
+}
+
+func (suite *IntegrationTestSuite) TestCardsStatsOddsEndpoints() {
+	/* */ log.Println("IntegrationTestSuite::TestCardsStatsOddsEndpoints : begin")
+	defer log.Println("IntegrationTestSuite::TestCardsStatsOddsEndpoints : end")
+
+	session := suite.newSession()
+	api := session.API()
+	ctx := context.Background()
+
+	// the ace of clubs is dealt
+	_, err := session.Deal(ctx)
+	require.NoError(suite.T(), err)
+
+	stats, err := api.DeckStatsWithResponse(ctx)
+	require.NoError(suite.T(), err)
+	require.NotNil(suite.T(), stats.JSON200)
+	assert.Equal(suite.T(), 51, stats.JSON200.Remaining)
+	assert.Equal(suite.T(), 12, stats.JSON200.Suits.AdditionalProperties["clubs"])
+	assert.Equal(suite.T(), 3, stats.JSON200.Values.AdditionalProperties["ace"])
+	assert.Equal(suite.T(), client.BlackjackPoints{Low: 339, High: 369}, stats.JSON200.Blackjack)
+
+	odds, err := api.DeckOddsWithResponse(ctx, &client.DeckOddsParams{Event: "ace"})
+	require.NoError(suite.T(), err)
+	require.NotNil(suite.T(), odds.JSON200)
+	assert.InDelta(suite.T(), 3.0/51, odds.JSON200.Probability, 1e-9)
+
+	draws := 52
+
+	odds, err = api.DeckOddsWithResponse(ctx, &client.DeckOddsParams{Event: "ace", Draws: &draws})
+	require.NoError(suite.T(), err)
+	assert.Equal(suite.T(), http.StatusConflict, odds.StatusCode())
+	// an event is not a card, so it is reported as an invalid request
+	odds, err = api.DeckOddsWithResponse(ctx, &client.DeckOddsParams{Event: "joker"})
+	require.NoError(suite.T(), err)
+	assert.Equal(suite.T(), http.StatusBadRequest, odds.StatusCode())
+	assert.Contains(suite.T(), string(odds.Body), `"request_invalid"`)
+}
+
+func (suite *IntegrationTestSuite) TestCardsResetEndpoint() {
+	/* */ log.Println("IntegrationTestSuite::TestCardsResetEndpoint : begin")
+	defer log.Println("IntegrationTestSuite::TestCardsResetEndpoint : end")
+
+	session := suite.newSession()
+	ctx := context.Background()
+
+	_, err := session.Deal(ctx)
+	require.NoError(suite.T(), err)
+
+	// a sorted standard deck by default
+	cards, err := session.Reset(ctx, nil)
+	require.NoError(suite.T(), err)
+	require.Len(suite.T(), cards, 52)
+	assert.Equal(suite.T(), client.Card{Value: "ace", Suit: "clubs"}, cards[0])
+
+	// a euchre deck in the given order: the clubs reversed, then the rest sorted
+	order, composition := client.DeckResetParamsOrder(client.Given), client.DeckResetParamsComposition(client.Euchre)
+	given := client.OrderCards("kcqcjctc9cacah9hthjhqhkhad9dtdjdqdkdas9stsjsqsks")
+
+	cards, err = session.Reset(ctx, &client.DeckResetParams{Order: &order, Cards: &given, Composition: &composition})
+	require.NoError(suite.T(), err)
+	require.Len(suite.T(), cards, 24)
+	assert.Equal(suite.T(), client.Card{Value: "king", Suit: "clubs"}, cards[0])
+
+	// the two of clubs is not a part of the euchre deck
+	_, err = session.Deal(ctx)
+	require.NoError(suite.T(), err)
+
+	_, err = session.Return(ctx, client.Card{Value: "two", Suit: "clubs"})
+	assert.ErrorIs(suite.T(), err, client.ErrCardForeign)
+
+	// the given order misses a card
+	given = given[2:]
+
+	_, err = session.Reset(ctx, &client.DeckResetParams{Order: &order, Cards: &given, Composition: &composition})
+	assert.ErrorIs(suite.T(), err, client.ErrOrderInvalid)
+}
+
+func (suite *IntegrationTestSuite) TestWarEndpoints() {
+	/* */ log.Println("IntegrationTestSuite::TestWarEndpoints : begin")
+	defer log.Println("IntegrationTestSuite::TestWarEndpoints : end")
+
+	session := suite.newSession()
+	api := session.API()
+	ctx := context.Background()
+
+	show, err := api.WarShowWithResponse(ctx)
+	require.NoError(suite.T(), err)
+	assert.Equal(suite.T(), http.StatusNotFound, show.StatusCode())
+
+	// the ace of clubs goes to the player 1 & the two of clubs to the player 2
+	start, err := api.WarStartWithResponse(ctx)
+	require.NoError(suite.T(), err)
+	require.NotNil(suite.T(), start.JSON201)
+	assert.Equal(suite.T(), client.WarGameStatusInProgress, start.JSON201.Status)
+	assert.Equal(suite.T(), []int{26, 26}, start.JSON201.Piles)
+
+	// the deck cannot be changed while its cards are in the game
+	_, err = session.Shuffle(ctx)
+	assert.ErrorIs(suite.T(), err, client.ErrGameInProgress)
+
+	step, err := api.WarStepWithResponse(ctx)
+	require.NoError(suite.T(), err)
+	require.NotNil(suite.T(), step.JSON200)
+	assert.Equal(suite.T(), [][]client.Card{{{Value: "ace", Suit: "clubs"}}, {{Value: "two", Suit: "clubs"}}}, step.JSON200.Played)
+	assert.Equal(suite.T(), 1, step.JSON200.Winner)
+	assert.Equal(suite.T(), []int{27, 25}, step.JSON200.Game.Piles)
+
+	play, err := api.WarPlayWithResponse(ctx)
+	require.NoError(suite.T(), err)
+	require.NotNil(suite.T(), play.JSON200)
+	assert.NotEqual(suite.T(), client.WarGameStatusInProgress, play.JSON200.Status)
+
+	step, err = api.WarStepWithResponse(ctx)
+	require.NoError(suite.T(), err)
+	assert.Equal(suite.T(), http.StatusConflict, step.StatusCode())
+
+	// all of the cards are put back into the deck
+	end, err := api.WarEndWithResponse(ctx)
+	require.NoError(suite.T(), err)
+	require.NotNil(suite.T(), end.JSON200)
+	assert.Len(suite.T(), *end.JSON200, 52)
+
+	cards, err := session.Shuffle(ctx)
+	require.NoError(suite.T(), err)
+	assert.Len(suite.T(), cards, 52)
+}
+
+func (suite *IntegrationTestSuite) TestKlondikeEndpoints() {
+	/* */ log.Println("IntegrationTestSuite::TestKlondikeEndpoints : begin")
+	defer log.Println("IntegrationTestSuite::TestKlondikeEndpoints : end")
+
+	session := suite.newSession()
+	api := session.API()
+	ctx := context.Background()
+
+	// the sorted deck puts the aces of clubs & hearts on top of the columns 1 & 3
+	start, err := api.KlondikeStartWithResponse(ctx, &client.KlondikeStartParams{})
+	require.NoError(suite.T(), err)
+	require.NotNil(suite.T(), start.JSON201)
+	assert.Equal(suite.T(), 24, start.JSON201.Stock)
+	require.Len(suite.T(), start.JSON201.Tableau, 7)
+	assert.Equal(suite.T(), []client.Card{{Value: "ace", Suit: "clubs"}}, start.JSON201.Tableau[0].Cards)
+
+	moves, err := api.KlondikeMovesWithResponse(ctx)
+	require.NoError(suite.T(), err)
+	require.NotNil(suite.T(), moves.JSON200)
+	assert.Contains(suite.T(), *moves.JSON200, client.KlondikeMove{From: "t1", To: "fc"})
+
+	move, err := api.KlondikeMoveWithResponse(ctx, client.KlondikeMoveJSONRequestBody{From: "t1", To: "fc"})
+	require.NoError(suite.T(), err)
+	require.NotNil(suite.T(), move.JSON200)
+	assert.Equal(suite.T(), 1, move.JSON200.Foundations.AdditionalProperties["clubs"])
+	assert.Equal(suite.T(), 1, move.JSON200.Moves)
+
+	// only a king goes onto the empty column
+	move, err = api.KlondikeMoveWithResponse(ctx, client.KlondikeMoveJSONRequestBody{From: "t2", To: "t1"})
+	require.NoError(suite.T(), err)
+	assert.Equal(suite.T(), http.StatusConflict, move.StatusCode())
+
+	move, err = api.KlondikeMoveWithResponse(ctx, client.KlondikeMoveJSONRequestBody{From: "t9", To: "t1"})
+	require.NoError(suite.T(), err)
+	assert.Equal(suite.T(), http.StatusBadRequest, move.StatusCode())
+
+	hint, err := api.KlondikeHintWithResponse(ctx)
+	require.NoError(suite.T(), err)
+	require.NotNil(suite.T(), hint.JSON200)
+	assert.Positive(suite.T(), hint.JSON200.Explored)
+
+	// the deck cannot be changed while its cards are in the game
+	_, err = session.Deal(ctx)
+	assert.ErrorIs(suite.T(), err, client.ErrGameInProgress)
+
+	end, err := api.KlondikeEndWithResponse(ctx)
+	require.NoError(suite.T(), err)
+	require.NotNil(suite.T(), end.JSON200)
+	assert.Len(suite.T(), *end.JSON200, 52)
+
+	show, err := api.KlondikeShowWithResponse(ctx)
+	require.NoError(suite.T(), err)
+	assert.Equal(suite.T(), http.StatusNotFound, show.StatusCode())
+}
+
+func (suite *IntegrationTestSuite) TestGoFishEndpoints() {
+	/* */ log.Println("IntegrationTestSuite::TestGoFishEndpoints : begin")
+	defer log.Println("IntegrationTestSuite::TestGoFishEndpoints : end")
+
+	session := suite.newSession()
+	api := session.API()
+	ctx := context.Background()
+
+	bot := "memory"
+
+	start, err := api.GoFishStartWithResponse(ctx, client.GoFishStartJSONRequestBody{
+		Players: []client.GoFishSeat{{Name: "ann"}, {Name: "bob", Bot: &bot}},
+	})
+	require.NoError(suite.T(), err)
+	require.NotNil(suite.T(), start.JSON201)
+	require.NotNil(suite.T(), start.JSON201.Hand)
+	require.NotNil(suite.T(), start.JSON201.Turn)
+	assert.Equal(suite.T(), client.GoFishPlayerName("ann"), *start.JSON201.Turn)
+	assert.Len(suite.T(), *start.JSON201.Hand, 7)
+	assert.Equal(suite.T(), 52-14, start.JSON201.Stock)
+	assert.Equal(suite.T(), &bot, start.JSON201.Players[1].Bot)
+
+	// the spectators see none of the hands
+	spectator, err := api.GoFishShowWithResponse(ctx, &client.GoFishShowParams{})
+	require.NoError(suite.T(), err)
+	require.NotNil(suite.T(), spectator.JSON200)
+	assert.Nil(suite.T(), spectator.JSON200.Hand)
+	assert.Equal(suite.T(), 7, spectator.JSON200.Players[1].Cards)
+
+	player := client.Player("cid")
+	show, err := api.GoFishShowWithResponse(ctx, &client.GoFishShowParams{Player: &player})
+	require.NoError(suite.T(), err)
+	assert.Equal(suite.T(), http.StatusNotFound, show.StatusCode())
+
+	// the bots cannot be played through the api
+	ask, err := api.GoFishAskWithResponse(ctx, client.GoFishAskJSONRequestBody{Player: "bob", Target: "ann", Value: "ace"})
+	require.NoError(suite.T(), err)
+	assert.Equal(suite.T(), http.StatusConflict, ask.StatusCode())
+
+	ask, err = api.GoFishAskWithResponse(ctx, client.GoFishAskJSONRequestBody{Player: "ann", Target: "bob", Value: "zz"})
+	require.NoError(suite.T(), err)
+	assert.Equal(suite.T(), http.StatusBadRequest, ask.StatusCode())
+
+	value := (*start.JSON201.Hand)[0].Value
+	ask, err = api.GoFishAskWithResponse(ctx, client.GoFishAskJSONRequestBody{Player: "ann", Target: "bob", Value: value})
+	require.NoError(suite.T(), err)
+	require.NotNil(suite.T(), ask.JSON200)
+	require.NotEmpty(suite.T(), ask.JSON200.Turns)
+	assert.Equal(suite.T(), client.GoFishPlayerName("ann"), ask.JSON200.Turns[0].Player)
+	assert.Equal(suite.T(), &value, ask.JSON200.Turns[0].Value)
+
+	// the deck cannot be changed while its cards are in the game
+	_, err = session.Deal(ctx)
+	assert.ErrorIs(suite.T(), err, client.ErrGameInProgress)
+
+	end, err := api.GoFishEndWithResponse(ctx)
+	require.NoError(suite.T(), err)
+	require.NotNil(suite.T(), end.JSON200)
+	assert.Len(suite.T(), *end.JSON200, 52)
+}
+
+func (suite *IntegrationTestSuite) TestBridgeEndpoints() {
+	/* */ log.Println("IntegrationTestSuite::TestBridgeEndpoints : begin")
+	defer log.Println("IntegrationTestSuite::TestBridgeEndpoints : end")
+
+	session := suite.newSession()
+	api := session.API()
+	ctx := context.Background()
+
+	shuffled, err := session.Shuffle(ctx)
+	require.NoError(suite.T(), err)
+
+	board := client.Board(6)
+	deal, err := api.BridgeDealWithResponse(ctx, &client.BridgeDealParams{Board: &board})
+	require.NoError(suite.T(), err)
+	require.NotNil(suite.T(), deal.JSON200)
+	assert.Equal(suite.T(), client.BridgeSeat("east"), deal.JSON200.Dealer)
+	assert.Equal(suite.T(), client.BridgeDealVulnerable("ew"), deal.JSON200.Vulnerable)
+	require.Len(suite.T(), deal.JSON200.Hands, 4)
+
+	hcp := 0
+	for _, hand := range deal.JSON200.Hands {
+		assert.Len(suite.T(), hand.Cards, 13)
+		hcp += hand.Hcp
+	}
+	assert.Equal(suite.T(), 40, hcp)
+
+	// the cards are left in the deck
+	cards, err := session.Show(ctx)
+	require.NoError(suite.T(), err)
+	assert.Equal(suite.T(), shuffled, cards)
+
+	pbn, err := api.BridgeDealPbnWithResponse(ctx, &client.BridgeDealPbnParams{Board: &board})
+	require.NoError(suite.T(), err)
+	require.Equal(suite.T(), http.StatusOK, pbn.StatusCode())
+	assert.Contains(suite.T(), string(pbn.Body), `[Dealer "E"]`)
+
+	// importing the board arranges a fresh deck so that it deals the same hands
+	_, err = session.Reset(ctx, &client.DeckResetParams{})
+	require.NoError(suite.T(), err)
+
+	imported, err := api.BridgeImportPbnWithBodyWithResponse(ctx, "text/plain", bytes.NewReader(pbn.Body))
+	require.NoError(suite.T(), err)
+	require.NotNil(suite.T(), imported.JSON200)
+	assert.Equal(suite.T(), deal.JSON200, imported.JSON200)
+	assert.Equal(suite.T(), "/bridge/deal?board=6", imported.HTTPResponse.Header.Get("Location"))
+
+	again, err := api.BridgeDealWithResponse(ctx, &client.BridgeDealParams{Board: &board})
+	require.NoError(suite.T(), err)
+	assert.Equal(suite.T(), deal.JSON200, again.JSON200)
+
+	imported, err = api.BridgeImportPbnWithBodyWithResponse(ctx, "text/plain", strings.NewReader(`[Board "1"]`))
+	require.NoError(suite.T(), err)
+	assert.Equal(suite.T(), http.StatusBadRequest, imported.StatusCode())
+
+	// a deck missing a card cannot be dealt
+	_, err = session.Deal(ctx)
+	require.NoError(suite.T(), err)
+
+	deal, err = api.BridgeDealWithResponse(ctx, &client.BridgeDealParams{})
+	require.NoError(suite.T(), err)
+	assert.Equal(suite.T(), http.StatusConflict, deal.StatusCode())
+
+	// the seeded sets are the same every time
+	first, count := client.FirstBoard(3), client.BoardCount(2)
+	boards, err := api.BridgeBoardsWithResponse(ctx, &client.BridgeBoardsParams{Seed: 42, First: &first, Count: &count})
+	require.NoError(suite.T(), err)
+	require.NotNil(suite.T(), boards.JSON200)
+	require.Len(suite.T(), *boards.JSON200, 2)
+	assert.Equal(suite.T(), 3, (*boards.JSON200)[0].Board)
+
+	set, err := api.BridgeBoardsPbnWithResponse(ctx, &client.BridgeBoardsPbnParams{Seed: 42, First: &first, Count: &count})
+	require.NoError(suite.T(), err)
+	require.Equal(suite.T(), http.StatusOK, set.StatusCode())
+
+	set2, err := api.BridgeBoardsPbnWithResponse(ctx, &client.BridgeBoardsPbnParams{Seed: 42, First: &first, Count: &count})
+	require.NoError(suite.T(), err)
+	assert.Equal(suite.T(), string(set.Body), string(set2.Body))
+	assert.Equal(suite.T(), 2, strings.Count(string(set.Body), "[Deal "))
+}
+
+func (suite *IntegrationTestSuite) TestHealthEndpoints() {
+	/* */ log.Println("IntegrationTestSuite::TestHealthEndpoints : begin")
+	defer log.Println("IntegrationTestSuite::TestHealthEndpoints : end")
+
+	c, err := client.NewClientWithResponses(suite.endpoint)
+	require.NoError(suite.T(), err)
+	ctx := context.Background()
+
+	healthz, err := c.HealthzWithResponse(ctx)
+	require.NoError(suite.T(), err)
+	require.Equal(suite.T(), http.StatusOK, healthz.StatusCode())
+	assert.Equal(suite.T(), client.HealthStatusOk, healthz.JSON200.Status)
+
+	// the compose environment waits for the service to become ready
+	readyz, err := c.ReadyzWithResponse(ctx)
+	require.NoError(suite.T(), err)
+	require.Equal(suite.T(), http.StatusOK, readyz.StatusCode())
+	assert.Equal(suite.T(), client.HealthStatusOk, readyz.JSON200.Status)
+
+	version, err := c.VersionWithResponse(ctx)
+	require.NoError(suite.T(), err)
+	require.Equal(suite.T(), http.StatusOK, version.StatusCode())
+	assert.NotEmpty(suite.T(), version.JSON200.Version)
+	assert.Equal(suite.T(), client.VersionStoreFile, version.JSON200.Store)
 }
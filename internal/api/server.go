@@ -16,27 +16,147 @@ type ServerInterface interface {
 	// Get documentation index.html that describes this api
 	// (GET /)
 	Index(ctx echo.Context) error
+	// List the sessions in memory ordered by their ids
+	// (GET /admin/sessions)
+	AdminListSessions(ctx echo.Context, params AdminListSessionsParams) error
+	// Delete a session; its id gets a fresh session when it is used again
+	// (DELETE /admin/sessions/{id})
+	AdminDeleteSession(ctx echo.Context, id SessionId) error
+	// Get a session's deck
+	// (GET /admin/sessions/{id})
+	AdminGetSession(ctx echo.Context, id SessionId) error
+	// Replace a session's deck with a new sorted one
+	// (POST /admin/sessions/{id}/reset)
+	AdminResetSession(ctx echo.Context, id SessionId) error
+	// Generate a set of bridge boards from a seed
+	// (GET /bridge/boards)
+	BridgeBoards(ctx echo.Context, params BridgeBoardsParams) error
+	// Generate a set of bridge boards from a seed in the Portable Bridge Notation
+	// (GET /bridge/boards.pbn)
+	BridgeBoardsPbn(ctx echo.Context, params BridgeBoardsPbnParams) error
+	// Deal the deck's 52 cards into the four hands of a bridge board
+	// (GET /bridge/deal)
+	BridgeDeal(ctx echo.Context, params BridgeDealParams) error
+	// Deal the deck's 52 cards into a bridge board in the Portable Bridge Notation
+	// (GET /bridge/deal.pbn)
+	BridgeDealPbn(ctx echo.Context, params BridgeDealPbnParams) error
+	// Import a bridge board in the Portable Bridge Notation by arranging the deck to deal it
+	// (POST /bridge/deal.pbn)
+	BridgeImportPbn(ctx echo.Context) error
+	// Render a single card as an SVG image
+	// (GET /card/{card})
+	CardRenderSvg(ctx echo.Context, card string, params CardRenderSvgParams) error
 	// Get the current state of the deck
 	// (GET /cards)
 	DeckShow(ctx echo.Context) error
+	// Render the current state of the deck as an SVG image
+	// (GET /cards.svg)
+	DeckRenderSvg(ctx echo.Context, params DeckRenderSvgParams) error
+	// Move the top cards to the bottom of the deck
+	// (POST /cards/cut)
+	DeckCut(ctx echo.Context, params DeckCutParams) error
 	// Deal the top card by removing it from the deck (in-browser testing helper)
 	// (GET /cards/deal)
 	DeckDealCard2(ctx echo.Context) error
 	// Deal the top card by removing it from the deck
 	// (POST /cards/deal)
 	DeckDealCard(ctx echo.Context) error
+	// Deal the card at the given position by removing it from the deck
+	// (POST /cards/deal/at)
+	DeckDealAt(ctx echo.Context, params DeckDealAtParams) error
+	// Deal the bottom card by removing it from the deck
+	// (POST /cards/deal/bottom)
+	DeckDealBottom(ctx echo.Context) error
+	// Remove the card specified in the body from wherever it is in the deck
+	// (POST /cards/draw)
+	DeckDrawCard(ctx echo.Context) error
+	// Insert the card specified in the body at the given position in the deck
+	// (POST /cards/insert)
+	DeckInsertCard(ctx echo.Context, params DeckInsertCardParams) error
+	// Get the chance of drawing at least the given number of the cards of a kind in the next cards
+	// (GET /cards/odds)
+	DeckOdds(ctx echo.Context, params DeckOddsParams) error
+	// Get the top cards without removing them from the deck
+	// (GET /cards/peek)
+	DeckPeek(ctx echo.Context, params DeckPeekParams) error
+	// Replace the deck with a new one in the given order (in-browser testing helper)
+	// (GET /cards/reset)
+	DeckReset2(ctx echo.Context, params DeckReset2Params) error
+	// Replace the deck with a new one in the given order
+	// (POST /cards/reset)
+	DeckReset(ctx echo.Context, params DeckResetParams) error
 	// Return the card specified in the '?card=' parameter to the back of the deck (in-browser testing helper)
 	// (GET /cards/return)
 	DeckReturnCard2(ctx echo.Context, params DeckReturnCard2Params) error
 	// Return the card specified in the body to the back of the deck
 	// (POST /cards/return)
 	DeckReturnCard(ctx echo.Context) error
-	// Permute the deck in an unbiased way (in-browser testing helper)
+	// Permute the deck in an unbiased way or with a model of a human shuffle (in-browser testing helper)
 	// (GET /cards/shuffle)
-	DeckShuffle2(ctx echo.Context) error
-	// Permute the deck in an unbiased way
+	DeckShuffle2(ctx echo.Context, params DeckShuffle2Params) error
+	// Permute the deck in an unbiased way or with a model of a human shuffle
 	// (POST /cards/shuffle)
-	DeckShuffle(ctx echo.Context) error
+	DeckShuffle(ctx echo.Context, params DeckShuffleParams) error
+	// Sort the deck from the lowest (on top) to the highest card in the given order
+	// (POST /cards/sort)
+	DeckSort(ctx echo.Context, params DeckSortParams) error
+	// Count the remaining cards by their suits and values
+	// (GET /cards/stats)
+	DeckStats(ctx echo.Context) error
+	// End the go fish game & put the cards back into the deck
+	// (DELETE /games/gofish)
+	GoFishEnd(ctx echo.Context) error
+	// Get the state of the go fish game as seen by a player
+	// (GET /games/gofish)
+	GoFishShow(ctx echo.Context, params GoFishShowParams) error
+	// Start a go fish game between 2 to 6 players, some of which may be bots
+	// (POST /games/gofish)
+	GoFishStart(ctx echo.Context) error
+	// Ask another player for the cards of a value in the go fish game
+	// (POST /games/gofish/asks)
+	GoFishAsk(ctx echo.Context) error
+	// End the klondike solitaire & put the cards back into the deck
+	// (DELETE /games/klondike)
+	KlondikeEnd(ctx echo.Context) error
+	// Get the state of the klondike solitaire
+	// (GET /games/klondike)
+	KlondikeShow(ctx echo.Context) error
+	// Start a klondike solitaire by dealing the deck's 52 cards into the tableau & the stock
+	// (POST /games/klondike)
+	KlondikeStart(ctx echo.Context, params KlondikeStartParams) error
+	// Search for a way to win the klondike solitaire
+	// (GET /games/klondike/hint)
+	KlondikeHint(ctx echo.Context) error
+	// List the legal moves of the klondike solitaire
+	// (GET /games/klondike/moves)
+	KlondikeMoves(ctx echo.Context) error
+	// Make a move in the klondike solitaire
+	// (POST /games/klondike/moves)
+	KlondikeMove(ctx echo.Context) error
+	// End the game of war & put the cards back into the deck
+	// (DELETE /games/war)
+	WarEnd(ctx echo.Context) error
+	// Get the state of the game of war
+	// (GET /games/war)
+	WarShow(ctx echo.Context) error
+	// Start a game of war by dealing the deck's cards into two piles
+	// (POST /games/war)
+	WarStart(ctx echo.Context) error
+	// Play the game of war until it is over
+	// (POST /games/war/play)
+	WarPlay(ctx echo.Context) error
+	// Play a single round of the game of war
+	// (POST /games/war/step)
+	WarStep(ctx echo.Context) error
+	// Check that the service is alive
+	// (GET /healthz)
+	Healthz(ctx echo.Context) error
+	// Check that the service is ready to serve the traffic
+	// (GET /readyz)
+	Readyz(ctx echo.Context) error
+	// Get the build information of the running service
+	// (GET /version)
+	Version(ctx echo.Context) error
 }
 
 // ServerInterfaceWrapper converts echo contexts to parameters.
@@ -53,6 +173,221 @@ func (w *ServerInterfaceWrapper) Index(ctx echo.Context) error {
 	return err
 }
 
+// AdminListSessions converts echo context to params.
+func (w *ServerInterfaceWrapper) AdminListSessions(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(AdminTokenScopes, []string{""})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params AdminListSessionsParams
+	// ------------- Optional query parameter "after" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "after", ctx.QueryParams(), &params.After)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter after: %s", err))
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", ctx.QueryParams(), &params.Limit)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.AdminListSessions(ctx, params)
+	return err
+}
+
+// AdminDeleteSession converts echo context to params.
+func (w *ServerInterfaceWrapper) AdminDeleteSession(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id SessionId
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "id", runtime.ParamLocationPath, ctx.Param("id"), &id)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	ctx.Set(AdminTokenScopes, []string{""})
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.AdminDeleteSession(ctx, id)
+	return err
+}
+
+// AdminGetSession converts echo context to params.
+func (w *ServerInterfaceWrapper) AdminGetSession(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id SessionId
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "id", runtime.ParamLocationPath, ctx.Param("id"), &id)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	ctx.Set(AdminTokenScopes, []string{""})
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.AdminGetSession(ctx, id)
+	return err
+}
+
+// AdminResetSession converts echo context to params.
+func (w *ServerInterfaceWrapper) AdminResetSession(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id SessionId
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "id", runtime.ParamLocationPath, ctx.Param("id"), &id)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	ctx.Set(AdminTokenScopes, []string{""})
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.AdminResetSession(ctx, id)
+	return err
+}
+
+// BridgeBoards converts echo context to params.
+func (w *ServerInterfaceWrapper) BridgeBoards(ctx echo.Context) error {
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params BridgeBoardsParams
+	// ------------- Required query parameter "seed" -------------
+
+	err = runtime.BindQueryParameter("form", true, true, "seed", ctx.QueryParams(), &params.Seed)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter seed: %s", err))
+	}
+
+	// ------------- Optional query parameter "first" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "first", ctx.QueryParams(), &params.First)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter first: %s", err))
+	}
+
+	// ------------- Optional query parameter "count" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "count", ctx.QueryParams(), &params.Count)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter count: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.BridgeBoards(ctx, params)
+	return err
+}
+
+// BridgeBoardsPbn converts echo context to params.
+func (w *ServerInterfaceWrapper) BridgeBoardsPbn(ctx echo.Context) error {
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params BridgeBoardsPbnParams
+	// ------------- Required query parameter "seed" -------------
+
+	err = runtime.BindQueryParameter("form", true, true, "seed", ctx.QueryParams(), &params.Seed)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter seed: %s", err))
+	}
+
+	// ------------- Optional query parameter "first" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "first", ctx.QueryParams(), &params.First)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter first: %s", err))
+	}
+
+	// ------------- Optional query parameter "count" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "count", ctx.QueryParams(), &params.Count)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter count: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.BridgeBoardsPbn(ctx, params)
+	return err
+}
+
+// BridgeDeal converts echo context to params.
+func (w *ServerInterfaceWrapper) BridgeDeal(ctx echo.Context) error {
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params BridgeDealParams
+	// ------------- Optional query parameter "board" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "board", ctx.QueryParams(), &params.Board)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter board: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.BridgeDeal(ctx, params)
+	return err
+}
+
+// BridgeDealPbn converts echo context to params.
+func (w *ServerInterfaceWrapper) BridgeDealPbn(ctx echo.Context) error {
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params BridgeDealPbnParams
+	// ------------- Optional query parameter "board" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "board", ctx.QueryParams(), &params.Board)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter board: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.BridgeDealPbn(ctx, params)
+	return err
+}
+
+// BridgeImportPbn converts echo context to params.
+func (w *ServerInterfaceWrapper) BridgeImportPbn(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.BridgeImportPbn(ctx)
+	return err
+}
+
+// CardRenderSvg converts echo context to params.
+func (w *ServerInterfaceWrapper) CardRenderSvg(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "card" -------------
+	var card string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "card", runtime.ParamLocationPath, ctx.Param("card"), &card)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter card: %s", err))
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params CardRenderSvgParams
+	// ------------- Optional query parameter "face_down" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "face_down", ctx.QueryParams(), &params.FaceDown)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter face_down: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.CardRenderSvg(ctx, card, params)
+	return err
+}
+
 // DeckShow converts echo context to params.
 func (w *ServerInterfaceWrapper) DeckShow(ctx echo.Context) error {
 	var err error
@@ -62,6 +397,49 @@ func (w *ServerInterfaceWrapper) DeckShow(ctx echo.Context) error {
 	return err
 }
 
+// DeckRenderSvg converts echo context to params.
+func (w *ServerInterfaceWrapper) DeckRenderSvg(ctx echo.Context) error {
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params DeckRenderSvgParams
+	// ------------- Optional query parameter "fan" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "fan", ctx.QueryParams(), &params.Fan)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter fan: %s", err))
+	}
+
+	// ------------- Optional query parameter "face_down" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "face_down", ctx.QueryParams(), &params.FaceDown)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter face_down: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.DeckRenderSvg(ctx, params)
+	return err
+}
+
+// DeckCut converts echo context to params.
+func (w *ServerInterfaceWrapper) DeckCut(ctx echo.Context) error {
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params DeckCutParams
+	// ------------- Required query parameter "count" -------------
+
+	err = runtime.BindQueryParameter("form", true, true, "count", ctx.QueryParams(), &params.Count)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter count: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.DeckCut(ctx, params)
+	return err
+}
+
 // DeckDealCard2 converts echo context to params.
 func (w *ServerInterfaceWrapper) DeckDealCard2(ctx echo.Context) error {
 	var err error
@@ -80,6 +458,174 @@ func (w *ServerInterfaceWrapper) DeckDealCard(ctx echo.Context) error {
 	return err
 }
 
+// DeckDealAt converts echo context to params.
+func (w *ServerInterfaceWrapper) DeckDealAt(ctx echo.Context) error {
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params DeckDealAtParams
+	// ------------- Required query parameter "index" -------------
+
+	err = runtime.BindQueryParameter("form", true, true, "index", ctx.QueryParams(), &params.Index)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter index: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.DeckDealAt(ctx, params)
+	return err
+}
+
+// DeckDealBottom converts echo context to params.
+func (w *ServerInterfaceWrapper) DeckDealBottom(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.DeckDealBottom(ctx)
+	return err
+}
+
+// DeckDrawCard converts echo context to params.
+func (w *ServerInterfaceWrapper) DeckDrawCard(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.DeckDrawCard(ctx)
+	return err
+}
+
+// DeckInsertCard converts echo context to params.
+func (w *ServerInterfaceWrapper) DeckInsertCard(ctx echo.Context) error {
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params DeckInsertCardParams
+	// ------------- Required query parameter "index" -------------
+
+	err = runtime.BindQueryParameter("form", true, true, "index", ctx.QueryParams(), &params.Index)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter index: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.DeckInsertCard(ctx, params)
+	return err
+}
+
+// DeckOdds converts echo context to params.
+func (w *ServerInterfaceWrapper) DeckOdds(ctx echo.Context) error {
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params DeckOddsParams
+	// ------------- Required query parameter "event" -------------
+
+	err = runtime.BindQueryParameter("form", true, true, "event", ctx.QueryParams(), &params.Event)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter event: %s", err))
+	}
+
+	// ------------- Optional query parameter "draws" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "draws", ctx.QueryParams(), &params.Draws)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter draws: %s", err))
+	}
+
+	// ------------- Optional query parameter "at_least" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "at_least", ctx.QueryParams(), &params.AtLeast)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter at_least: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.DeckOdds(ctx, params)
+	return err
+}
+
+// DeckPeek converts echo context to params.
+func (w *ServerInterfaceWrapper) DeckPeek(ctx echo.Context) error {
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params DeckPeekParams
+	// ------------- Optional query parameter "count" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "count", ctx.QueryParams(), &params.Count)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter count: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.DeckPeek(ctx, params)
+	return err
+}
+
+// DeckReset2 converts echo context to params.
+func (w *ServerInterfaceWrapper) DeckReset2(ctx echo.Context) error {
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params DeckReset2Params
+	// ------------- Optional query parameter "order" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "order", ctx.QueryParams(), &params.Order)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter order: %s", err))
+	}
+
+	// ------------- Optional query parameter "cards" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "cards", ctx.QueryParams(), &params.Cards)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter cards: %s", err))
+	}
+
+	// ------------- Optional query parameter "composition" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "composition", ctx.QueryParams(), &params.Composition)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter composition: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.DeckReset2(ctx, params)
+	return err
+}
+
+// DeckReset converts echo context to params.
+func (w *ServerInterfaceWrapper) DeckReset(ctx echo.Context) error {
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params DeckResetParams
+	// ------------- Optional query parameter "order" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "order", ctx.QueryParams(), &params.Order)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter order: %s", err))
+	}
+
+	// ------------- Optional query parameter "cards" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "cards", ctx.QueryParams(), &params.Cards)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter cards: %s", err))
+	}
+
+	// ------------- Optional query parameter "composition" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "composition", ctx.QueryParams(), &params.Composition)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter composition: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.DeckReset(ctx, params)
+	return err
+}
+
 // DeckReturnCard2 converts echo context to params.
 func (w *ServerInterfaceWrapper) DeckReturnCard2(ctx echo.Context) error {
 	var err error
@@ -111,8 +657,24 @@ func (w *ServerInterfaceWrapper) DeckReturnCard(ctx echo.Context) error {
 func (w *ServerInterfaceWrapper) DeckShuffle2(ctx echo.Context) error {
 	var err error
 
+	// Parameter object where we will unmarshal all parameters from the context
+	var params DeckShuffle2Params
+	// ------------- Optional query parameter "method" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "method", ctx.QueryParams(), &params.Method)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter method: %s", err))
+	}
+
+	// ------------- Optional query parameter "times" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "times", ctx.QueryParams(), &params.Times)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter times: %s", err))
+	}
+
 	// Invoke the callback with all the unmarshalled arguments
-	err = w.Handler.DeckShuffle2(ctx)
+	err = w.Handler.DeckShuffle2(ctx, params)
 	return err
 }
 
@@ -120,8 +682,231 @@ func (w *ServerInterfaceWrapper) DeckShuffle2(ctx echo.Context) error {
 func (w *ServerInterfaceWrapper) DeckShuffle(ctx echo.Context) error {
 	var err error
 
+	// Parameter object where we will unmarshal all parameters from the context
+	var params DeckShuffleParams
+	// ------------- Optional query parameter "method" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "method", ctx.QueryParams(), &params.Method)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter method: %s", err))
+	}
+
+	// ------------- Optional query parameter "times" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "times", ctx.QueryParams(), &params.Times)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter times: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.DeckShuffle(ctx, params)
+	return err
+}
+
+// DeckSort converts echo context to params.
+func (w *ServerInterfaceWrapper) DeckSort(ctx echo.Context) error {
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params DeckSortParams
+	// ------------- Optional query parameter "order" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "order", ctx.QueryParams(), &params.Order)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter order: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.DeckSort(ctx, params)
+	return err
+}
+
+// DeckStats converts echo context to params.
+func (w *ServerInterfaceWrapper) DeckStats(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.DeckStats(ctx)
+	return err
+}
+
+// GoFishEnd converts echo context to params.
+func (w *ServerInterfaceWrapper) GoFishEnd(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.GoFishEnd(ctx)
+	return err
+}
+
+// GoFishShow converts echo context to params.
+func (w *ServerInterfaceWrapper) GoFishShow(ctx echo.Context) error {
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GoFishShowParams
+	// ------------- Optional query parameter "player" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "player", ctx.QueryParams(), &params.Player)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter player: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.GoFishShow(ctx, params)
+	return err
+}
+
+// GoFishStart converts echo context to params.
+func (w *ServerInterfaceWrapper) GoFishStart(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.GoFishStart(ctx)
+	return err
+}
+
+// GoFishAsk converts echo context to params.
+func (w *ServerInterfaceWrapper) GoFishAsk(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.GoFishAsk(ctx)
+	return err
+}
+
+// KlondikeEnd converts echo context to params.
+func (w *ServerInterfaceWrapper) KlondikeEnd(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.KlondikeEnd(ctx)
+	return err
+}
+
+// KlondikeShow converts echo context to params.
+func (w *ServerInterfaceWrapper) KlondikeShow(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.KlondikeShow(ctx)
+	return err
+}
+
+// KlondikeStart converts echo context to params.
+func (w *ServerInterfaceWrapper) KlondikeStart(ctx echo.Context) error {
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params KlondikeStartParams
+	// ------------- Optional query parameter "draw" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "draw", ctx.QueryParams(), &params.Draw)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter draw: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.KlondikeStart(ctx, params)
+	return err
+}
+
+// KlondikeHint converts echo context to params.
+func (w *ServerInterfaceWrapper) KlondikeHint(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.KlondikeHint(ctx)
+	return err
+}
+
+// KlondikeMoves converts echo context to params.
+func (w *ServerInterfaceWrapper) KlondikeMoves(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.KlondikeMoves(ctx)
+	return err
+}
+
+// KlondikeMove converts echo context to params.
+func (w *ServerInterfaceWrapper) KlondikeMove(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.KlondikeMove(ctx)
+	return err
+}
+
+// WarEnd converts echo context to params.
+func (w *ServerInterfaceWrapper) WarEnd(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.WarEnd(ctx)
+	return err
+}
+
+// WarShow converts echo context to params.
+func (w *ServerInterfaceWrapper) WarShow(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.WarShow(ctx)
+	return err
+}
+
+// WarStart converts echo context to params.
+func (w *ServerInterfaceWrapper) WarStart(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.WarStart(ctx)
+	return err
+}
+
+// WarPlay converts echo context to params.
+func (w *ServerInterfaceWrapper) WarPlay(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.WarPlay(ctx)
+	return err
+}
+
+// WarStep converts echo context to params.
+func (w *ServerInterfaceWrapper) WarStep(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.WarStep(ctx)
+	return err
+}
+
+// Healthz converts echo context to params.
+func (w *ServerInterfaceWrapper) Healthz(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.Healthz(ctx)
+	return err
+}
+
+// Readyz converts echo context to params.
+func (w *ServerInterfaceWrapper) Readyz(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.Readyz(ctx)
+	return err
+}
+
+// Version converts echo context to params.
+func (w *ServerInterfaceWrapper) Version(ctx echo.Context) error {
+	var err error
+
 	// Invoke the callback with all the unmarshalled arguments
-	err = w.Handler.DeckShuffle(ctx)
+	err = w.Handler.Version(ctx)
 	return err
 }
 
@@ -154,12 +939,52 @@ func RegisterHandlersWithBaseURL(router EchoRouter, si ServerInterface, baseURL
 	}
 
 	router.GET(baseURL+"/", wrapper.Index)
+	router.GET(baseURL+"/admin/sessions", wrapper.AdminListSessions)
+	router.DELETE(baseURL+"/admin/sessions/:id", wrapper.AdminDeleteSession)
+	router.GET(baseURL+"/admin/sessions/:id", wrapper.AdminGetSession)
+	router.POST(baseURL+"/admin/sessions/:id/reset", wrapper.AdminResetSession)
+	router.GET(baseURL+"/bridge/boards", wrapper.BridgeBoards)
+	router.GET(baseURL+"/bridge/boards.pbn", wrapper.BridgeBoardsPbn)
+	router.GET(baseURL+"/bridge/deal", wrapper.BridgeDeal)
+	router.GET(baseURL+"/bridge/deal.pbn", wrapper.BridgeDealPbn)
+	router.POST(baseURL+"/bridge/deal.pbn", wrapper.BridgeImportPbn)
+	router.GET(baseURL+"/card/:card", wrapper.CardRenderSvg)
 	router.GET(baseURL+"/cards", wrapper.DeckShow)
+	router.GET(baseURL+"/cards.svg", wrapper.DeckRenderSvg)
+	router.POST(baseURL+"/cards/cut", wrapper.DeckCut)
 	router.GET(baseURL+"/cards/deal", wrapper.DeckDealCard2)
 	router.POST(baseURL+"/cards/deal", wrapper.DeckDealCard)
+	router.POST(baseURL+"/cards/deal/at", wrapper.DeckDealAt)
+	router.POST(baseURL+"/cards/deal/bottom", wrapper.DeckDealBottom)
+	router.POST(baseURL+"/cards/draw", wrapper.DeckDrawCard)
+	router.POST(baseURL+"/cards/insert", wrapper.DeckInsertCard)
+	router.GET(baseURL+"/cards/odds", wrapper.DeckOdds)
+	router.GET(baseURL+"/cards/peek", wrapper.DeckPeek)
+	router.GET(baseURL+"/cards/reset", wrapper.DeckReset2)
+	router.POST(baseURL+"/cards/reset", wrapper.DeckReset)
 	router.GET(baseURL+"/cards/return", wrapper.DeckReturnCard2)
 	router.POST(baseURL+"/cards/return", wrapper.DeckReturnCard)
 	router.GET(baseURL+"/cards/shuffle", wrapper.DeckShuffle2)
 	router.POST(baseURL+"/cards/shuffle", wrapper.DeckShuffle)
+	router.POST(baseURL+"/cards/sort", wrapper.DeckSort)
+	router.GET(baseURL+"/cards/stats", wrapper.DeckStats)
+	router.DELETE(baseURL+"/games/gofish", wrapper.GoFishEnd)
+	router.GET(baseURL+"/games/gofish", wrapper.GoFishShow)
+	router.POST(baseURL+"/games/gofish", wrapper.GoFishStart)
+	router.POST(baseURL+"/games/gofish/asks", wrapper.GoFishAsk)
+	router.DELETE(baseURL+"/games/klondike", wrapper.KlondikeEnd)
+	router.GET(baseURL+"/games/klondike", wrapper.KlondikeShow)
+	router.POST(baseURL+"/games/klondike", wrapper.KlondikeStart)
+	router.GET(baseURL+"/games/klondike/hint", wrapper.KlondikeHint)
+	router.GET(baseURL+"/games/klondike/moves", wrapper.KlondikeMoves)
+	router.POST(baseURL+"/games/klondike/moves", wrapper.KlondikeMove)
+	router.DELETE(baseURL+"/games/war", wrapper.WarEnd)
+	router.GET(baseURL+"/games/war", wrapper.WarShow)
+	router.POST(baseURL+"/games/war", wrapper.WarStart)
+	router.POST(baseURL+"/games/war/play", wrapper.WarPlay)
+	router.POST(baseURL+"/games/war/step", wrapper.WarStep)
+	router.GET(baseURL+"/healthz", wrapper.Healthz)
+	router.GET(baseURL+"/readyz", wrapper.Readyz)
+	router.GET(baseURL+"/version", wrapper.Version)
 
 }
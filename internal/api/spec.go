@@ -18,21 +18,145 @@ import (
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
 
-	"H4sIAAAAAAAC/+xX328bNwz+VwhtQDfAqZ1sLztgGLZ1GArsYWj3VuSBlng+tXeSSvGSHAr/7wMl/0ji",
-	"OC66Itiwvvh8J0r8+PGjKH0wNg4pBgqSTfPBZNvRgOXvr8hOn4ljIhZP1WD0ok+6wSH1ZBrTEbJkMzOD",
-	"D39QWElnmvOZkSnpaBb2YWXWM3OF/Uh3p74ficKpmeuZYXo/eiZnmjebZWYVyOXOOi7fkhX18xtz5EPg",
-	"A+WMqwLgcQdbw8O11dLGkMdBl3xjMKXeWxQfw/xtjkGn+NBG9eEoW/ZJx0xjfobsNWbIgkLt2ANTFsDk",
-	"IRNfEUMbGRAc2XcQW7DITikVL4Wo8n7WiaQztfdWGbgiznX58+eL5wuNPSYKmLxpzHfl08wklK7EP9ef",
-	"FZXkKS8F9ktnGvMyOLoxSkJOMeRK18VioQ8bg1Aok4RuZN7J0O918hCZ69m92L0u/1wngnQoUEeXlEE6",
-	"n5WDkoI8DgPyZBrzOwm4aMeBghSU8JFLzCttx+J8Qfbd6y5enw71ILF3IvZCQ5n4NVNrGvPVfF9D800B",
-	"zUv1rHcSQmacHmLnr47AjswUpIpD0y8dFSk8QIyctK8szB1h/ygVLwh7RXnxT/k4TcORsJFdzec1ZlC8",
-	"pX6/X/zw2dzXveCI/1JsPgMNSSbAoGCICZAJQoQhcsWYQWKBdy8dSmChXmKqwSwnYBrilQ8r8AItx2GX",
-	"G/jGh7Mlx+tMDEJZ1KijPhF/q2GnmE8k6kueniJPt2uISUYOj1bRq2KyraOEjAMJsfaH+03gdRdZztrI",
-	"A0SGPoZVfaFgo1Mom0qufEeo3vXfDpv2l9o3eTIzE3DYdgftiDuq9x0WbdkgPq5Hry8PFHZ+2Mt2klA1",
-	"5NFayrkd+37aACa3hbzE2s321KpqFk+jmgLRxrF3EKLAkiAhZ3JPKt0CAnsmdBPQjc+SNfdyS9VK3VbU",
-	"G9AYNojROQV8R82vNqrYWudE1reeHPj69dlP+v3HZ7AT47F8fPqWtFe9qYcnyvJLdNNn34/WXxT531fk",
-	"MrrpeAb2223uxrbt6cQBrthc/OsOcQeHMcBWa68Gtb1o7Fn7k3gYhW4xHwADjGHpMZNqefr0+tyw9H8g",
-	"qbpKHN1oj1/LCtg6rLcoc7n+OwAA//+wkEeY+g4AAA==",
+	"H4sIAAAAAAAC/+x923LcOLLgryC4EyEphrr60m0pJk643TM9vae7x2t5eyLW9ipQZFYRIxKoBkCVNQ69",
+	"7F/s0z7tB+wv7RfsJ2xkAiBBFlkXWZLdZ/Riq0gQSCAvyEwkMj8lmarmSoK0Jjn9lMy55hVY0PTrO8V1",
+	"jn/kYDIt5lYomZwmbwtgE3zFZF1NQKdsUYisYAasYbYAlgMvQbP39dHRyXN6clWXEjSfiFLY6yRNBPbz",
+	"Ww0af0heQXKaUJdJmpisgIq7Yae8Lm1yepwmFf8oqrpKTl+8ePEiTSoh3c/jNLHXc/xeSAsz0MnNTeog",
+	"f6VqaYfBd4AzNSXoaGTDhKRfBuwIhBl1OAzh8wjE45Nv10L4CtfdCAfTEIgZwYQASViwHLJLJgyreA5M",
+	"TU8ZL0v27MTPoGK7xnKZc53vpX4SVyANq+eMy5ye8AwM252L32qwe0xp17WQMNAK6qzQsPdeji5EC/zg",
+	"ciQBnCRNQOIqvIsfOSjwHQ2UfGiWyFgt5IxW6C88g+/VYmB5zgu1cIjj2aUJWHQLprktACfHCZtCsynO",
+	"aWQi+O4iV4uRaUx5aaCBbaJUCVx64Abg+guXESSqtkhSXDKusw5cJb8WcuYQhy2YVotRALcHTWhjV7Bu",
+	"l/an2Nqz81oGoMZ3xKI/yhw+DkMYaCsAhMSfsiM2Ab9szKr5CIiCuk0TDb/VQkOenFpdQwwyfOTVvITk",
+	"9CiC8WgQxr/pHPQwjErn7SIGDj1jOzNxBXKHca25nIGJ6IE3EmaHHuywRtqOMhqNMsZiSlvoMFh4YIp6",
+	"Oi3pTwJnmL9ocq8QkuUZvizLMDec147xcwgUUiht2VTpik21qhAdzCo2Udaqiu2GtWeTa7dOfyIwVsgT",
+	"gmIQSQkvfjNPcCqVkD+BnNkiOT0Zms/rkl+PYWtO79iiUAZYgcJOGJzFYgwi90EHpD9omCanyX86bLfM",
+	"Q/fWHP6g/iJM4SD4BXtAgM4BRljQAORhfQ1Y/NPtQSPQYPvNaPrpSZogXrh1pPz8aTJI2edgjFDyx1EA",
+	"6TUTeYBozm0RcdlqcJaRc+5I8mewhRoY8yWbg55CZstrprnMVYUPqtpybMB2p8IUoPevuQWzlzLOfhDl",
+	"BLTdPy+4lEruvwFA4S9wkBRlrroCTYhWmnGGkMyZ54vUPfMjsinXil0CzCPhQuTOlKS/d7HFvqrdtlmp",
+	"K2woLFK8Q2CmZI4kloFvK1bQeuWWYJin43lGnN177KaZpEmYJPaGM0zSJMAa/hQj3H+utF0h3kwtbMPu",
+	"jQLihN5uVtYTk7ICuLYmZbnglZK5SZmZ8xzMHlsIW7TqRKkWbFfCYh/7oDUsxKxguzyDffzLqywTLfIZ",
+	"9MZo+w6juTF6Q1A3Qam54mUNxu9sA6BMrvc1l5d7t5K6YR4RdqJHYUpJmrjp4B9uuGE0vBUVmLX7NDZC",
+	"ctMwB269CK49EQzNgL5Yv1EfHx0drdmob9JEg5kraRycP/AKfpSvtZppMPQkU9KCU7T5fF6KjHj2cK7V",
+	"pITqj/8wTsPdTJC+dl+5gfsyYsYrQMHt9AAS0XmL4HijSm5SgvQXZf+iapk/JJxvC9AEplQO4g64jY5F",
+	"AhbhfMMt/CQqYeGhwWzEvGecrBQgLSu4YfCx4LWxCLAlpRpYiTCySZ3PSD8sgOfeTnwDVl/vv5xa0OtI",
+	"2QlLIuYFx+5gqjQwjT0gTwzsIhEtthvXl0asqcngbZD4XyWvbaG0+OfDY5HnFVKVugRJJqIwBglOaSbk",
+	"FS9FTpLGd4ZjvcQP/ELi77lWc9BWOA7PgkooLFRmHWyoQOICeGSh3nuNv0U+rAi0SsM7p0W44VrhqCb/",
+	"gMxiFzGYr/kMlkGV8HHEwN/hSI2Rit2q6h8tm/MZnLUL5Viy5Ma9SZYEdZp4VG++LjHw53VVcX29vEy9",
+	"9WgGWbcaocOlBRlc9TQx4p+wjjO7Cr4bZ8ewsNcFBfPZyaA+uYRWGnJoHt+VPLv8B88uXyvhvU5D6kfV",
+	"OGdCezanD8JzDRUXEvEXrIfuUtA2PNg5aQLky4GcccOOj+MJPvl2wBZMk1ItNuyt09nTo7XLVZLxT+AO",
+	"rhfpEd8DL5fRPQlWfjPg8RDszh+3jmLdQOfAaVhUK0eQQ68QDVJpW6QMuLEpM6q2RfD5LYD8BBtxihv3",
+	"r6jGDsiR4DksiYAblUtJSNJEItoBl2+iLC1fsxChyWoJFHyOfoU6w4U1GEcKwTwuP8d8epNrUq/ZrlNl",
+	"hxRp0n73vHHtiIx5N9VnSeUim4/gVMyKfQQvcNnuU7TvyXuVQcqeuF/sUshZyk78r99qABlwfuwfIq/u",
+	"xUxwfDJElAa43YYkl2Qlt83u4SY2jqjX3/0yPG1yk6Xse0J/yn5tsB8mhW+Y5TOid7gCfd31lb1W2lJ7",
+	"NxD7RTmTNZ5/8o6GYe+T4/fJh/fy3ffeP5784n7Hwya/KAltM3xw+vLfXzw7+M/fPDl4+/zk4L98+5T5",
+	"/+kZvmThD3r69vkJ8/8fhE+px6GNLVriDn9pMveRtZM0Id5O0oTYusdlruFSv6+4HuANpPuue8fRfte9",
+	"czzQHxl13U+J+tZ92SMb103qABkiGIT77aBa8VJeNz4b8n/tvk948T7ZS1mp5Ix+Zuic9/xMb4jRzXU1",
+	"USU2ePl//+f/fp+QpVpLkakc2Ky8nhf47v/9r//xf/Adum+I3DjJi/ddWsIehtb7e8guX53/ugz2K1VV",
+	"fN8AakIWcrZDS5AiYDtMQ6ZQJJERxZnT6Tvjta3fS5QEfm6SFj91Euy9HIPob3lulqmA24uSKCtG56CQ",
+	"yDVfmE6zZ0PN8LBjjK6W4Kq4zQr8ewPveE/JCI8vBflcWgn3ZAgqVPbDkVfH73zw5Pk3R0+ffxu56nJV",
+	"uz3Hd+PASIh6PQjdZVivhrlFCWuYtqseLUHcfRfgId5AjJ5bbgdQ2mhpa0V6T/3baoaObx0N5TmdEfDy",
+	"dQeQIe1naxwDR+sORUSE5E8J7coO22Grdr88sdHfjiXw75uBJXS+qYeZQJB10Qx4hj7iNLmk1X66DGGP",
+	"hmLqcEvfTCGNcD5GLMOCFI8+eWbZroWP9nBeciH30r48bN+dMcclf6I3JDs7UnWgpXu1xzTMNRiQ3o0c",
+	"nWj0pGpzyLAkLZxn/6W57BDnp3BAcJpwiV1Zrmdg6SRukjS7ld+ibvq2ybw5qtjuUKEdZ/svmw10mZzo",
+	"FePmEnLS4TqnPLu/0ZK7LY7m47aozvrdZitujlj8nAKIQ8TkJoQevWXRU3gtfEzd9li/ErBo/HD6cxVp",
+	"18vm7oAYI0P9GcttbWIVTMiLefCyOk9/V/Pqvl82+a3KLoeXpSs93CKVMKUDDdwsYsyePB2SwrbW8lbU",
+	"W2s5YhrRq+AkNUJmMIC1HeO8NNxcpsxYri2+I+UF7anGi6PRNUgTpF6xAZ7G8153/gRgc/y9xXkPYG8h",
+	"pAQ9MjNPKq2julJ04q4wdCHMM7i2Cc/p9jTVrPBK/5IjskAcLRUHzIyzXnu42vdAqEuzQqqYNsYG51ty",
+	"kQdDtqGxd434sAuVfIhmv0TV/YWfqJ7aV0Gl6CBk6dMVZvkQQ3gZ2NCdP2ZbrYdKL6C2PiqOsUSdtJat",
+	"W+F1mPnFjxxtabQvzbm1gMya/Pd3L/f/G9//59H+i4v9D5+O0+PnN38Y3/GCRdhH94i/1VjNLcyund0y",
+	"UZbtTrgRGZ2YEk72UidkkBGnSkera5Cf3eGrVvXMH9fNRWeHGUfs3S36+CKfg63ngxqASU7fffIw0Jrf",
+	"pH6ZWpCbALdJcvNhRBlYIzw8OcZRJ0I78baduAj+vYp//NF99Zy2bf/jZI0ICcCOL9Vbvzf0TL4ZFwMR",
+	"U38vwIdFhamymQKUizHyR2Kd0ruRPlsJHNwf5eZShJq3brwgd1e7a2fKbj4CyiXIad9AxyK+aPSpONJp",
+	"eZivWwk9a6VFs3G6cdmigFg4s1zDwjtLmJBWMS4ZVHN7vSS0241mM80UMRFwnnoaXiWR/wq8tMUy9S9r",
+	"dwrJoJb8iouSvM0d3Y7erobRdzkExb+XSubiEl6psq7kVg5qjIfcr+eethq69YFdVsXBd5/lhxZ5Dhvx",
+	"EUGEPNsc86zyGPUWyQ+z6pAxLNawbYGoX3++MkV1k0zMO7bsvQHjqL0dpeOiOOvGLAkXcqgkuE649Zy1",
+	"CwezA+/Hpy8KDT7Ed8DFEXs4IgfHUevfOBpyb1TqCjZSsqghRTNvcFKwxjZaKHk/plGP9hq7uC/FTwbl",
+	"K50L8HpjE7HHtkNmBjcWVkaL11pC3vItQXpvbDxmXXgb0tFCa2w46Lvc0q7SKt78q5ADuih8nJdKw0ZR",
+	"ziGi2DADXGcFdN23R8NmLs5gU6z9jG2354BAUqqs+8dGx8ffruaGYVWKN52xBTdOarDdxsas+DUzVpQl",
+	"mwBDuxXX322pwqL0qOWlVAu51wksLq/8kah/2+W36P1Gm1ba4m4V2n/2yx+JJyTl5DSxT3AolZwm02LZ",
+	"u5a19z7amLfNRC0iBR0HaMR4ymSZ48fVcXKpB20zYnktSqdIqe2+6C0nDUm9rFpG+nTEaFMYWI/TJ+ZM",
+	"GY93mck1BX+5s+pplrJpkbIpRdRODTkF+4vEdu0xiZdvehuLx1hfEofApmUVReWd83502V6QTkeH9dnl",
+	"xbQuS7+xX+S1i7AKZvNFLedcG/A0SY+mSoOYIVBkQ12E0Cj/2scCUThlDh8vVG0v1PSCgvjDkOQP9XHP",
+	"YGzUhVT2gmcZzK0fsoJc8Auc70UtTT2fh9B8bElLnKQ+EviCPi5LtaAGmlu4KH04IKmIbVxZE4Z0EXeD",
+	"bH3R3fLo0VIb71tCGr8QZQkzXjY+oE5rpGoteXkBWque27GDiSWE5mC5KDtMmwSXOwqX0Q+jPb4JZT96",
+	"MbitClv2XB1vNxnBPYg/q7U8JbbfL6yd7xvQVyKDUx+jd7pqpj1GpLcBtDTaCd1ypI6eh5j0V9DDUXiV",
+	"ysVUQD4u6IltlXaeEw3ACp6zWmaqqoS1kLOscFdQOEZtijKnOOKN7GoNV8KM3ky7ygwLLdzu5VaO9hsc",
+	"yZLKkXojjPaWsG9EoydP828hy46zZ/nx9JifTJ5kT/Nn8Hz6Df928iI7yo/hZPqEP52M6HAaVt9doCZ0",
+	"RQxkfE2mcctMRd/uoidDwQctlpbHq1Rel8B8m5Tt7OZwBeXejpt5uyC0Ndc6A5YVkF26IP3opP344OTg",
+	"aC2tBVgiLIXlGCKwv3M9bNrMRQm39Yfi3SX6PPajnHybnjwdcKbEvNtTaMlPvxEQrqUbPr8bk8ErqttY",
+	"Ds7Pv/KK0e4xbowne3jXiC08g6D4beNNa1mCMR23/6Kn+q21bBsR45cw9fgcIYE3IVa6SwOzDVyngYDQ",
+	"00oo6drDT0ZdSysP5ch89Us2ry155bpOTlvANVuA9iubnzG6KrngOvWBWI1vvm+ncY3WsttSvVNM6Ma3",
+	"gfFVnXOfz/JkLNtoeiOCRhSECWu/7672121LfFapy5aB8f6kwGtmtmCaS3KsNVG/eY0U7lZ3Ow+LJ4kG",
+	"5X4BGmid6jFAlsikkNVa2OtzXGJoI9PfYiT78DxdkDvdJmyvX/nNB72E+/sUDL9P7cJlAtrcgGsCx4OB",
+	"O76Lp8+UNDUN/64Tqk8h+ijO5FQNXVgzAteIISPCtC6ZBmPx1IIAAu2DId21qWnjvPLaS7KsdyTRNpMc",
+	"HxwdHCHS1Rwkn4vkNHlCj+hEp6C1OsR/vB8WmZpbf6HPX63tXdw5OTrqXUygwInCVmX3JkJ/+1nyU5GG",
+	"fIAfOv+Sezuhy67C4Bo49IYg9eQHQAbP6qqJxNiwi0PC5mEcfD84YSKbn4Sx56Fl2kll8K6Pvr/J8pqV",
+	"wtg42t0wujTgQBDeaN7BCwM7+DO4EnDbVbWhqwJ7I/evqKNk5ZXIQVXCXczq3JfxoIX9191PGBqTjIaR",
+	"O190zWuLW18f1hLPEqtsfJtl6VrH4F0vnGi8AMgMT4+OxzpvoD3s3MSJxQyRQSxg3n24+RCT6U9L9CCk",
+	"P7x0e1K8mwjHznzmBAd2m3wYoNnDTyK/ccgowcII7X5PL/2iLBPv0IzbJoftZd4BxD1drSWjzu5Ay2+5",
+	"xPjR0/Uf9S9ubYUatz6MB7DPyDMhcjYDa9BrocEU7Zwib5aBnIWDmz620hXS5Aew94KO++GjNRf8/D2e",
+	"gcv6XzHOcdfgyzeRNmQ5hMIhd67MGJLfYJv/UGgOV7W/Yry+AXdVvo/bEISOU3CpLJi/wjOAcXe7+tBn",
+	"TWj1gu7a/Dm+sGEoL1FjitOQIVNGGNsA5CwHLa46xyhgd4x7F+U06qZAMl4b5ZVP78DLBb82pKua9pUD",
+	"mOyPLj26exjfhSwQ25IiIW9tuyg1zAatoyRKn03hW9wEo6tuy4dNg4Tv8d9XNyXQjWXeJNegjn3rxhcD",
+	"XkHoEtPBfCJHFc0YS68n8neJqDZeenMh1F6jWokI1l4zmVx7918pJHwGhtZetIoxmPtbkoPCoHU+cA1e",
+	"FigJjOM2Y0UFLCtVdrkQBvrBpVGwibc6c7rCteMCZ9MgFrBjfBAnLjpgvyhtC/rCRILjOJYl+JLNuTFg",
+	"YigWhShhOYGad2643kJaLXb8fN/1rP3SjEoZ4rBtSddT431udTH/ryAzt029eOhr9rRh5AoMk8qygl8B",
+	"JWHzZuGzkzbzxNOTF+v30DjtQ5c73J3DNqNF6NoFN/lQEN1ew+Ud7lliiA0EGo54G3G2MU3cn8zZMVtc",
+	"4vzXppwunawXrGmjOo/mfwTwOQr9DddWojVrHkux75cSQ0bIUhKMl2ytmNwxjThzZl2ICjxg8amfdjpl",
+	"0OOWF7fvW+a2L5B9jLdpwwvDY++NEcZJ6Bz3gJjB2K4BN7+flCMjf5Fyb1QC/1jNlbaO5fxx8ncqv757",
+	"runm6Lr5osJbVO4YnIVb91EKmbByw9RGq+wx2u3lrCF3ptCn1yK1aeewGsLeukglvV24lDdoE0NAMbkM",
+	"3suRnHQx9v+NevzTs4GDuxsSN0cPLW4cb2aqLnMSODQrjbNya+CS2FRKg0tHqSR8AcE4ks5J6RajwglM",
+	"n/P0roWl48EtpSLqtS63Y8gWR5Ba5WhUuJONQ4Tx8BP+ezO69eKJ0huQOejzq9k6h3V0+Rx7ZSAzlQs5",
+	"M/2DLrZzYK5mOww+WpDerzGQwC9zHLhBRsHkt+LAeACbuyQHf3z/Hp/+Yei4er11EzK7bqA5iIrP4NBc",
+	"zf74ceuDiqDx+yNCtqs0xQPsJV+GMbMRvuwRpiMKxhmeFpf+M24Yl+z81x8YrUhLZuOnInRdu6DsMvdv",
+	"u4+GiNImlpmrzVcxpDJovt5yC2xuHo+hodYapHVHd/Gl4DsQKui0tKvHaBBHbLUKeSukw1oOk8lXyIgm",
+	"NowHU8eGe6hazAq7dwcI8cy0EicrmOswq1c4kYlSKYxnpfAei6mxigJOz9ypvH81x4Ala1hVGxvERIhC",
+	"W5WUfANRfvL8no/9/qNJigFKmdqGmuyXNCdDqCNTmu0QDewEfclHF3EZm4KYj80nvl2dRvOu1CuM3u5c",
+	"TTHBd+Z5fVgo9n14ywyHJgbS0Elyj9bMq+BJeXiibDIQrdIiyIJ1Z7e8/DoI0ZcQ0EBuUKmchdFgHgF9",
+	"UAJsnCGBAFFD1tDmb27Od2geu0LuT7RaGNDMgiHnbwHlHPRe7AoZp8dHcnwkxzsjx75EPORr1BDs/qXd",
+	"WlF0oWL36tV/pN3b7OnkwkLkfF27ekPFzih1xo6Lx2yqdmxF1z4MZS1tf+faPdLpo4zdgDq9irmdmPV3",
+	"vVfQoeaLV63jbNhp/7snwJtHI3BzIxAJDHmSKCzEDAn71TkYv4iwCAkJEJZOKacHFAlvCDEtsswcMrpY",
+	"FyCaqPzaoW1RgIYr0D50NII4FhNCGtBrlLEfqY0XFbdXyB5FzKOIaUWMIzzwOW4eZUwEDi818PyawUdh",
+	"rEk7J4h4UXxJoZ5p4DYqE/jF9GknKdaJp2E9e0RAqXzNmRAlZd7AZY1Zjpcc1qglnVK2JQszvBmxqyFP",
+	"XZUGygzAM6AKXS5tgEscQw98Ghp8z1T/eGuXG3roEmj7OlKjBZpCauNNji83SzB+k6532lPpkM5CjIAX",
+	"Mi6PVF9aWRdxPRgup5iHA20COimYQC859RBgUQroNbAdPfCloIYux9i84DJrpujw/2XkH439FQlAknMF",
+	"N2wKC9AtXUgX5orEcpfnmg0esGe6NGoZ0VQkoQaTWE1d9Yi8LSoXuCkWXnOAy5XC6zU2uP15W4mXYrk9",
+	"86vFXe0plzZJTDsbB6X7cNlYt6wF/HjKtpX20x4Q3c9ZfNt/SATc+AOoBO+oR6C5QrTihN6APdlayXeF",
+	"F2/SzRq+8rrG2tZxTelHKusLhM7NqAffNpxkdOGnI6F53lLm0keYQQXSDpXhjXaa1bzRK9Z4F9a0u7vV",
+	"yMnozpaSEGR7PNlbn6sRbz2y1iNrPbLWMGt1t6qQk3/FXoVNQuTESgXqHFWffazy4Gob+T/jshtLFYxC",
+	"RGonTNUqr16196boEu9YEfCxGuBxXaW1ptwje97Kge214BCkw13ikkc/06ifqR+rTp4mfyTmwefSw87z",
+	"HPIHdnw7vhv1LO38Gz7/U1wjtYf7z4+NaWXO45nZo8h5FDn/4iKHnNkjQibWZkJx+9U3G6jN9sa3//Bn",
+	"yr66ie7vavQ/qhUb8rhDns+Y+GV06degq9pGurSQqPvXciK4QcrnFGPlNexK5VA6B2VRV1z6GcDttz1P",
+	"Yo+k+Uia90OaHWGp1oUjnGOLrWlRaeudKY/k1SMvd7G4dXl8CUJC/PTvUhXA8C6osWxXock93wu7Ldb3",
+	"xudZdMd1xJVgQoXX8a2XWtzzaaAbZEw1wtMXM1IA9Q4Wl5LpDPXdJgCkcqike/mCqLSEqGiZw5maClN0",
+	"s/4NZaZqMti6+lQ+LwJeWGczxRwKh0p/+Q9NunT7l1L6D6QdcMWY/izzx8ug67aKrlpM2YlRV904ORpy",
+	"epsZ7bNJ8c9eu58phkTlVHlPKQhbCylC2eaICdJpNBeSoizyTUJvlyaBa2CuatJZVDbTNUrjxBpN81Fi",
+	"81ePt9t1QoXU+4w1iIrJbkIUM2rYIP8BLUCXqEGqLu6VxkembhNoCxls5rs5MO1OPx6bG2YAXBYEP/p4",
+	"npg/84xutZ4gyE/aUoYhEd83nm49MYNvTjHkofEz1+bMy2JjER6rWlkX5YFx2WiQRik/UlsMm2StCz8S",
+	"lhWqzI37bKKsYZZfQlxP0d09Zhw1sVpaUfp4UOur1Dp1zC/9cOVK17sDwFh+HbJtNzLG9RtngAeZQ5zN",
+	"pLXjXRmHnFXAJaXSGec4y7W9J0dbXAdzo8wyxw/Ir3hG0qdPypMltLGrUfWFXE2N2JW8Aid2kQ6lc9dM",
+	"VFyK6YsmZwn+p9EkLd28ViBpce9KDyOKZrwrhCZgF4jkExQEz1tdyKiKpNaiEFlBha4mjsWXVbNDblzh",
+	"0PEMV64Spacdn2vtCnS8B3bri6N8IXcblMbZd57imjKVfGFCncpuiTYnL7rNfR1Ub7nOwNqQcYZGOnP7",
+	"90IYaCWTzyDYSVI6JOFuKdZGBQ+Wx79PsYP9P3A6q1soCX3p08HnF5Izbtcb9Wl//RrNw7vduWkyT/my",
+	"XEHedYq0f75we2kuGZexGt5kKYtiJr3eIpeUsViqXfpab6tMzredGqKmtTCXrchuVTcTlYiLZcuYrRkK",
+	"zz1am79fazMQFDOqFJYLfRubc5gs7iIl1SYlE29r4D3oeg+aXMuLvzoZZz+rsVYL5GT8r0HNN4GZcRAq",
+	"0vhNFBQbtIMmUpbypdXzs54P7OSpf9+zwloybsK6O1TuBM2Dm0UNyXnD6HZR44NFda2KhGKTQnrFhZDR",
+	"WHFfoew4ffJho2Dx4wfllGBa/Z6MkPtJrhtskQHROHGJR+OUkIN5m8O+2t9Eh7byw8JXPR504L1kE5RA",
+	"kPuCxs42cfWJVCWozhxVGD5jm5T+laqtGix80eAV/EQFmR9AhNM4o4T50dIUnZoUwE/xGoeS0BY/vr1o",
+	"f3jd8441zHNHG64UGfqzrELEj24yA1TY1LNeuaf/TK0eQtnr19vepEoElbp17JCG6vhNNrJYJSZ/0ZdS",
+	"BZryUxG4t9YI/F7PNfgCpKHAIi+pti85EzJVqlo3/gJKoEyXtGbB+2AV49In+3B9ni0tWjtGPUdJSFHC",
+	"zW7JMzhgL/3HO6bNfiSanZVqMmbQ1B/PkPphlfwh5N+Pw6FLXw/rc7iV5hpF1lWeJx7Yz/CSisGucTJ8",
+	"9aKXtpIHsft/Rncc9wNuIowXXK8z7L3/4HjHOGQE7yGyW+fMmJ2EJruRFuL0FJdbX7aqiqct7u8cL7gO",
+	"V9Fdgp2hBPd/5/rR8P8dHzNz70Tn+rMt/r9zfd/GflOd+Pdo50drvZ2B3y1bNFqrqCkx9CDGNmK7OYC8",
+	"J4t1Dbp/H8Zqe1mfrunfvYUa8/CwaRrbpQvli7l3dxyUgNerj8iaGdP+4DcLrC575Ou1p8wISaX+YpDQ",
+	"DnXO56mihEvDxIShIF+T6PDzE7ajCnwBWYILsySr4yM9B1wXm8bCfDU2Q8gFHQbW8yWXYKQuUDShJjGE",
+	"+VwMPdijc0bTCqB8RdpnoYnozkiGuasJWl2GyBIq8i6kP8m1EXBuL9IAsZ+SbJsAXgu9cxE4IH0HTkTG",
+	"mTIuAeYO5pIb19qq0MvemJiD+T1T5htfV3OQNIm52nyQbrH/hd0cxBBNPiG3OkNbLHJEgRto8c9Rj8Zf",
+	"/ft7RK8bYlTu+Hr2tJOIK+hHpha4hRDu7VjrQ9qAxuf4xr3+glP09bXJWzsBkEyDsUpD3lxmiiZGf/r9",
+	"y2o+nYoMiebZ0ZOHRwkIOq5tVC70XVBoh5ABRCH3pyWFkHn/BBlipqhtTsE8G6LTKRFW0TPoTh5xfAXa",
+	"+IJZg0j+1b+/RyyHIcbqUNWizJmQeHm8rdW5rBMvtQvMq2tJq+pXxY0z1yqvM3AVgfugf/CHLO51Ye0c",
+	"H/kCwku33B0ZsopL7jMChPN3t5RKmzPm3T9uj6ASxMzSTuXC58MpmEcc7S/7+9Ru37Xb9dEziNRcGLSr",
+	"8ya2Vli3x4QEXfhdcvPh5v8PAKX/b0CZwQAA",
 }
 
 // GetSwagger returns the content of the embedded swagger specification file
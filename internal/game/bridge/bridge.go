@@ -0,0 +1,243 @@
+// Package bridge deals the boards of contract bridge: four hands of 13 cards with the dealer & the vulnerability
+// rotating with the board number as at the clubs, exported to & imported from the Portable Bridge Notation (PBN)
+package bridge
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/AntonAverchenkov/cards-http-service/internal/game"
+)
+
+const (
+	// HandSize is the number of the cards in every hand
+	HandSize = 13
+
+	// cycle is the number of the boards after which the dealers & the vulnerabilities repeat
+	cycle = 16
+)
+
+// Seat is one of the four players around the table
+type Seat uint8
+
+// Seat values in the clockwise order
+const (
+	SeatNorth Seat = iota
+	SeatEast
+	SeatSouth
+	SeatWest
+	SeatsTotalCount // a marker for the end of this enum
+)
+
+// ParseSeat will parse the given seat string in short (n) or long (north) forms
+func ParseSeat(str string) (Seat, error) {
+	switch strings.ToLower(str) {
+	case "n", "north":
+		return SeatNorth, nil
+	case "e", "east":
+		return SeatEast, nil
+	case "s", "south":
+		return SeatSouth, nil
+	case "w", "west":
+		return SeatWest, nil
+	default:
+		return 0, &game.ParseError{Input: str, As: "seat"}
+	}
+}
+
+func (s Seat) String() string {
+	return [...]string{
+		"north",
+		"east",
+		"south",
+		"west",
+	}[s]
+}
+
+func (s Seat) ShortString() string {
+	return [...]string{
+		"N",
+		"E",
+		"S",
+		"W",
+	}[s]
+}
+
+// Next returns the seat to the left, i.e. the next one clockwise
+func (s Seat) Next() Seat {
+	return (s + 1) % SeatsTotalCount
+}
+
+// Vulnerability tells which of the partnerships are vulnerable
+type Vulnerability uint8
+
+// Vulnerability values
+const (
+	VulnerableNone Vulnerability = iota
+	VulnerableNS
+	VulnerableEW
+	VulnerableBoth
+)
+
+// ParseVulnerability will parse the vulnerability in the form written by String or by PBN ("None", "NS", "EW"
+// or "All"), also accepting the PBN's "Love", "-" & "Both"
+func ParseVulnerability(str string) (Vulnerability, error) {
+	switch strings.ToLower(str) {
+	case "none", "love", "-":
+		return VulnerableNone, nil
+	case "ns":
+		return VulnerableNS, nil
+	case "ew":
+		return VulnerableEW, nil
+	case "both", "all":
+		return VulnerableBoth, nil
+	default:
+		return 0, &game.ParseError{Input: str, As: "vulnerability"}
+	}
+}
+
+func (v Vulnerability) String() string {
+	return [...]string{
+		"none",
+		"ns",
+		"ew",
+		"both",
+	}[v]
+}
+
+// PBN returns the vulnerability as written in the PBN's Vulnerable tag
+func (v Vulnerability) PBN() string {
+	return [...]string{
+		"None",
+		"NS",
+		"EW",
+		"All",
+	}[v]
+}
+
+// vulnerabilities is the standard rotation over the 16 boards, which shifts by a seat every 4 boards
+var vulnerabilities = [cycle]Vulnerability{
+	VulnerableNone, VulnerableNS, VulnerableEW, VulnerableBoth,
+	VulnerableNS, VulnerableEW, VulnerableBoth, VulnerableNone,
+	VulnerableEW, VulnerableBoth, VulnerableNone, VulnerableNS,
+	VulnerableBoth, VulnerableNone, VulnerableNS, VulnerableEW,
+}
+
+// Dealer returns the dealer of the board: north deals the board 1 & the deal passes clockwise
+func Dealer(board int) Seat {
+	return Seat((board - 1) % int(SeatsTotalCount))
+}
+
+// Vulnerable returns the vulnerability of the board
+func Vulnerable(board int) Vulnerability {
+	return vulnerabilities[(board-1)%cycle]
+}
+
+// Deal is a board with the four hands in the seat order (north first)
+type Deal struct {
+	Board      int
+	Dealer     Seat
+	Vulnerable Vulnerability
+
+	// Hands are sorted by suit (spades, hearts, diamonds, clubs) & then from the ace down
+	Hands [SeatsTotalCount][]game.Card
+}
+
+// New deals the 52 cards of the board one at a time clockwise, starting with the player to the dealer's left
+func New(board int, cards []game.Card) (*Deal, error) {
+	if board < 1 {
+		return nil, &game.ParseError{Input: strconv.Itoa(board), As: "board number"}
+	}
+
+	if len(cards) != game.DeckCapacity {
+		return nil, fmt.Errorf("%w: bridge needs all %d cards, got %d", game.ErrDeckShort, game.DeckCapacity, len(cards))
+	}
+
+	d := &Deal{
+		Board:      board,
+		Dealer:     Dealer(board),
+		Vulnerable: Vulnerable(board),
+	}
+
+	seat := d.Dealer
+	for _, card := range cards {
+		seat = seat.Next()
+		d.Hands[seat] = append(d.Hands[seat], card)
+	}
+
+	for _, hand := range d.Hands {
+		sortHand(hand)
+	}
+
+	return d, nil
+}
+
+// Cards returns the order of the cards which New deals into the same hands for the board; the dealer of the
+// board's rotation is the one dealing, even if the deal names another one (e.g. when imported from PBN)
+func (d *Deal) Cards() []game.Card {
+	cards := make([]game.Card, 0, game.DeckCapacity)
+
+	for i := 0; i < HandSize; i++ {
+		seat := Dealer(d.Board)
+		for range d.Hands {
+			seat = seat.Next()
+			cards = append(cards, d.Hands[seat][i])
+		}
+	}
+
+	return cards
+}
+
+// HCP counts the high-card points of the hand: 4 for an ace, 3 for a king, 2 for a queen & 1 for a jack
+func HCP(hand []game.Card) int {
+	points := 0
+
+	for _, card := range hand {
+		switch card.Value {
+		case game.ValueAce:
+			points += 4
+		case game.ValueKing:
+			points += 3
+		case game.ValueQueen:
+			points += 2
+		case game.ValueJack:
+			points++
+		}
+	}
+
+	return points
+}
+
+// Set generates the count boards from the first one on, each dealt from a deck shuffled with a seed derived from
+// the set's seed & the board number; so a board is the same whichever boards are generated along with it
+func Set(seed int64, first, count int) ([]*Deal, error) {
+	if first < 1 || first > math.MaxInt-count {
+		return nil, &game.ParseError{Input: strconv.Itoa(first), As: "board number"}
+	}
+
+	deals := make([]*Deal, 0, count)
+
+	for board := first; board < first+count; board++ {
+		deck := game.NewDeck(game.WithSeed(seed*1000003 + int64(board)))
+		deck.Shuffle()
+
+		d, err := New(board, deck.Cards)
+		if err != nil {
+			return nil, err
+		}
+
+		deals = append(deals, d)
+	}
+
+	return deals, nil
+}
+
+// sortHand sorts the hand from the highest card to the lowest one in the bridge order
+func sortHand(hand []game.Card) {
+	sort.SliceStable(hand, func(i, j int) bool {
+		return game.OrderBridge.Compare(hand[i], hand[j]) > 0
+	})
+}
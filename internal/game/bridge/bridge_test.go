@@ -0,0 +1,163 @@
+package bridge
+
+import (
+	"math"
+	"testing"
+
+	"github.com/AntonAverchenkov/cards-http-service/internal/game"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func serialize(cards []game.Card) string {
+	return (&game.Deck{Cards: cards}).Serialize()
+}
+
+func TestRotation(t *testing.T) {
+	dealers := []Seat{SeatNorth, SeatEast, SeatSouth, SeatWest, SeatNorth}
+	for i, dealer := range dealers {
+		assert.Equal(t, dealer, Dealer(i+1))
+	}
+
+	assert.Equal(t, VulnerableNone, Vulnerable(1))
+	assert.Equal(t, VulnerableBoth, Vulnerable(4))
+	assert.Equal(t, VulnerableNone, Vulnerable(8))
+	assert.Equal(t, VulnerableNS, Vulnerable(12))
+	assert.Equal(t, VulnerableEW, Vulnerable(16))
+	assert.Equal(t, VulnerableNone, Vulnerable(17))
+}
+
+func TestNew(t *testing.T) {
+	// the new deck is sorted by suit, so every hand gets every 4th value of each suit
+	d, err := New(2, game.NewDeck().Cards)
+	require.NoError(t, err)
+
+	assert.Equal(t, SeatEast, d.Dealer)
+	assert.Equal(t, VulnerableNS, d.Vulnerable)
+
+	// east deals, so south gets the first card
+	assert.Equal(t, "ts6s2sqh8h4hjd7d3dackc9c5c", serialize(d.Hands[SeatSouth]))
+	assert.Equal(t, "asks9s5sjh7h3htd6d2dqc8c4c", serialize(d.Hands[SeatEast]))
+
+	for _, hand := range d.Hands {
+		assert.Len(t, hand, HandSize)
+	}
+
+	// the cards deal back into the same hands
+	again, err := New(2, d.Cards())
+	require.NoError(t, err)
+	assert.Equal(t, d, again)
+
+	_, err = New(1, game.NewDeck().Cards[1:])
+	assert.ErrorIs(t, err, game.ErrDeckShort)
+
+	_, err = New(0, game.NewDeck().Cards)
+	assert.ErrorIs(t, err, game.ErrUnparseable)
+}
+
+func TestHCP(t *testing.T) {
+	deck, err := game.DeckDeserialize("askhqdjc2s3h")
+	require.NoError(t, err)
+
+	assert.Equal(t, 10, HCP(deck.Cards))
+
+	// there are 40 points in the deck
+	total := 0
+	d, err := New(1, game.NewDeck().Cards)
+	require.NoError(t, err)
+
+	for _, hand := range d.Hands {
+		total += HCP(hand)
+	}
+	assert.Equal(t, 40, total)
+}
+
+func TestSet(t *testing.T) {
+	set, err := Set(42, 1, 16)
+	require.NoError(t, err)
+	require.Len(t, set, 16)
+
+	assert.Equal(t, 16, set[15].Board)
+	assert.NotEqual(t, set[0].Hands, set[1].Hands)
+
+	// a board is the same whichever boards are generated along with it
+	again, err := Set(42, 5, 2)
+	require.NoError(t, err)
+	assert.Equal(t, set[4], again[0])
+
+	other, err := Set(43, 1, 1)
+	require.NoError(t, err)
+	assert.NotEqual(t, set[0].Hands, other[0].Hands)
+
+	// the board numbers past the last int would wrap around
+	_, err = Set(42, math.MaxInt, 2)
+	assert.ErrorIs(t, err, game.ErrUnparseable)
+
+	_, err = Set(42, 0, 2)
+	assert.ErrorIs(t, err, game.ErrUnparseable)
+}
+
+func TestPBN(t *testing.T) {
+	d, err := New(2, game.NewDeck().Cards)
+	require.NoError(t, err)
+
+	assert.Equal(t, `[Board "2"]
+[Dealer "E"]
+[Vulnerable "NS"]
+[Deal "E:AK95.J73.T62.Q84 T62.Q84.J73.AK95 J73.AK95.Q84.T62 Q84.T62.AK95.J73"]
+`, d.PBN())
+
+	set, err := Set(7, 1, 4)
+	require.NoError(t, err)
+
+	parsed, err := ParsePBN(EncodePBN(set))
+	require.NoError(t, err)
+	assert.Equal(t, set, parsed)
+}
+
+func TestParsePBN(t *testing.T) {
+	deals, err := ParsePBN(`% PBN 2.1
+[Event "club night"]
+[Board "3"]
+[Deal "N:AKQJ.AKQ.AKQ.AKQ T987.JT9.JT9.JT9 6543.876.876.876 2.5432.5432.5432"]
+[Auction "S"]
+1C Pass`)
+	require.NoError(t, err)
+	require.Len(t, deals, 1)
+
+	// the dealer & the vulnerability follow the board's rotation
+	d := deals[0]
+	assert.Equal(t, SeatSouth, d.Dealer)
+	assert.Equal(t, VulnerableEW, d.Vulnerable)
+	assert.Equal(t, 37, HCP(d.Hands[SeatNorth]))
+
+	// the cards deal back into the same hands whoever the pbn names the dealer
+	d.Dealer = SeatWest
+	again, err := New(d.Board, d.Cards())
+	require.NoError(t, err)
+	assert.Equal(t, d.Hands, again.Hands)
+	assert.Equal(t, "2s5h4h3h2h5d4d3d2d5c4c3c2c", serialize(d.Hands[SeatWest]))
+
+	for _, pbn := range []string{
+		"",
+		`[Board "1"]`,
+		`[Board "x"]` + "\n" + `[Deal "N:AKQJ.AKQ.AKQ.AKQ T987.JT9.JT9.JT9 6543.876.876.876 2.5432.5432.5432"]`,
+		`[Board "1"]` + "\n" + `[Deal "N:AKQJ.AKQ.AKQ.AKQ T987.JT9.JT9.JT9 6543.876.876.876"]`,
+		`[Board "1"]` + "\n" + `[Deal "N:AKQJ.AKQ.AKQ.AKQ T987.JT9.JT9.JT9 6543.876.876.876 2.5432.5432.543"]`,
+		`[Board "1"]` + "\n" + `[Deal "N:AKQJ.AKQ.AKQ.AKQ AKQJ.AKQ.AKQ.AKQ 6543.876.876.876 2.5432.5432.5432"]`,
+		`[Board "1"]` + "\n" + `[Dealer "X"]` + "\n" + `[Deal "N:AKQJ.AKQ.AKQ.AKQ T987.JT9.JT9.JT9 6543.876.876.876 2.5432.5432.5432"]`,
+	} {
+		_, err := ParsePBN(pbn)
+		assert.ErrorIs(t, err, game.ErrUnparseable, pbn)
+	}
+}
+
+func TestParseSeat(t *testing.T) {
+	seat, err := ParseSeat("West")
+	require.NoError(t, err)
+	assert.Equal(t, SeatWest, seat)
+	assert.Equal(t, SeatNorth, seat.Next())
+
+	_, err = ParseSeat("up")
+	assert.ErrorIs(t, err, game.ErrUnparseable)
+}
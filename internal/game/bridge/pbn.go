@@ -0,0 +1,223 @@
+package bridge
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/AntonAverchenkov/cards-http-service/internal/game"
+)
+
+// pbnRanks are the card values as written in a PBN hand, from the highest to the lowest
+const pbnRanks = "AKQJT98765432"
+
+// pbnSuits are the suits in the order of a PBN hand
+var pbnSuits = [...]game.Suit{game.SuitSpades, game.SuitHearts, game.SuitDiamonds, game.SuitClubs}
+
+// pbnTag matches a tag pair such as '[Board "1"]'
+var pbnTag = regexp.MustCompile(`^\[(\w+)\s+"([^"]*)"\]$`)
+
+// PBN encodes the deal as the Board, Dealer, Vulnerable & Deal tags of a PBN game; the Deal tag lists the hands
+// clockwise from the dealer's one, e.g. 'N:AKQ.J94.T62.A83 ...'
+func (d *Deal) PBN() string {
+	hands := make([]string, 0, len(d.Hands))
+
+	seat := d.Dealer
+	for range d.Hands {
+		hands = append(hands, pbnHand(d.Hands[seat]))
+		seat = seat.Next()
+	}
+
+	return fmt.Sprintf("[Board \"%d\"]\n[Dealer \"%s\"]\n[Vulnerable \"%s\"]\n[Deal \"%s:%s\"]\n",
+		d.Board, d.Dealer.ShortString(), d.Vulnerable.PBN(), d.Dealer.ShortString(), strings.Join(hands, " "))
+}
+
+// EncodePBN encodes the deals as the games of a PBN file, separated by empty lines
+func EncodePBN(deals []*Deal) string {
+	games := make([]string, 0, len(deals))
+	for _, d := range deals {
+		games = append(games, d.PBN())
+	}
+
+	return strings.Join(games, "\n")
+}
+
+// ParsePBN decodes the games of a PBN file; every game needs the Board & the Deal tags with all of the 52 cards,
+// while the Dealer & the Vulnerable ones follow the board's rotation when left out. The other tags, the comments
+// & the auction or play sections are skipped.
+func ParsePBN(text string) ([]*Deal, error) {
+	var (
+		deals []*Deal
+		tags  = make(map[string]string)
+	)
+
+	flush := func() error {
+		if len(tags) == 0 {
+			return nil
+		}
+
+		d, err := parseGame(tags)
+		if err != nil {
+			return err
+		}
+
+		deals = append(deals, d)
+		tags = make(map[string]string)
+
+		return nil
+	}
+
+	for _, line := range strings.Split(text, "\n") {
+		line = strings.TrimSpace(line)
+
+		switch {
+		case line == "":
+			// the games are separated by the empty lines
+			if err := flush(); err != nil {
+				return nil, err
+			}
+		case strings.HasPrefix(line, "%"):
+			continue
+		default:
+			if m := pbnTag.FindStringSubmatch(line); m != nil {
+				tags[m[1]] = m[2]
+			}
+		}
+	}
+
+	if err := flush(); err != nil {
+		return nil, err
+	}
+
+	if len(deals) == 0 {
+		return nil, &game.ParseError{Input: text, As: "pbn"}
+	}
+
+	return deals, nil
+}
+
+func parseGame(tags map[string]string) (*Deal, error) {
+	board, err := strconv.Atoi(tags["Board"])
+	if err != nil || board < 1 {
+		return nil, &game.ParseError{Input: tags["Board"], As: "board number"}
+	}
+
+	deal, ok := tags["Deal"]
+	if !ok {
+		return nil, fmt.Errorf("the board %d has no deal: %w", board, &game.ParseError{Input: "", As: "pbn deal"})
+	}
+
+	d := &Deal{
+		Board:      board,
+		Dealer:     Dealer(board),
+		Vulnerable: Vulnerable(board),
+	}
+
+	if dealer, ok := tags["Dealer"]; ok {
+		if d.Dealer, err = ParseSeat(dealer); err != nil {
+			return nil, err
+		}
+	}
+
+	if vulnerable, ok := tags["Vulnerable"]; ok {
+		if d.Vulnerable, err = ParseVulnerability(vulnerable); err != nil {
+			return nil, err
+		}
+	}
+
+	if d.Hands, err = parseDeal(deal); err != nil {
+		return nil, err
+	}
+
+	return d, nil
+}
+
+// parseDeal decodes the Deal tag, i.e. the first hand's seat & the four hands clockwise from it
+func parseDeal(str string) ([SeatsTotalCount][]game.Card, error) {
+	var hands [SeatsTotalCount][]game.Card
+
+	first, rest, found := strings.Cut(str, ":")
+	if !found {
+		return hands, &game.ParseError{Input: str, As: "pbn deal"}
+	}
+
+	seat, err := ParseSeat(first)
+	if err != nil {
+		return hands, err
+	}
+
+	fields := strings.Fields(rest)
+	if len(fields) != len(hands) {
+		return hands, &game.ParseError{Input: str, As: "pbn deal"}
+	}
+
+	seen := make(map[game.Card]bool, game.DeckCapacity)
+
+	for _, field := range fields {
+		hand, err := parseHand(field)
+		if err != nil {
+			return hands, err
+		}
+
+		for _, card := range hand {
+			if seen[card] {
+				return hands, fmt.Errorf("the card %s is dealt twice: %w", card, &game.ParseError{Input: str, As: "pbn deal"})
+			}
+
+			seen[card] = true
+		}
+
+		hands[seat] = hand
+		seat = seat.Next()
+	}
+
+	return hands, nil
+}
+
+// parseHand decodes a hand written as 'spades.hearts.diamonds.clubs' with the ranks from pbnRanks
+func parseHand(str string) ([]game.Card, error) {
+	suits := strings.Split(str, ".")
+	if len(suits) != len(pbnSuits) {
+		return nil, &game.ParseError{Input: str, As: "pbn hand"}
+	}
+
+	hand := make([]game.Card, 0, HandSize)
+
+	for i, ranks := range suits {
+		for _, r := range strings.ToUpper(ranks) {
+			value, err := game.ParseValue(string(r))
+			if err != nil || !strings.ContainsRune(pbnRanks, r) {
+				return nil, &game.ParseError{Input: str, As: "pbn hand"}
+			}
+
+			hand = append(hand, game.Card{Suit: pbnSuits[i], Value: value})
+		}
+	}
+
+	if len(hand) != HandSize {
+		return nil, fmt.Errorf("the hand has %d cards: %w", len(hand), &game.ParseError{Input: str, As: "pbn hand"})
+	}
+
+	sortHand(hand)
+
+	return hand, nil
+}
+
+func pbnHand(hand []game.Card) string {
+	suits := make([]string, 0, len(pbnSuits))
+
+	for _, suit := range pbnSuits {
+		var b strings.Builder
+
+		for _, card := range hand {
+			if card.Suit == suit {
+				b.WriteByte(pbnRanks[len(pbnRanks)-1-game.RankAceHigh.Rank(card.Value)])
+			}
+		}
+
+		suits = append(suits, b.String())
+	}
+
+	return strings.Join(suits, ".")
+}
@@ -3,6 +3,7 @@ package game
 import (
 	"fmt"
 	"strings"
+	"unicode/utf8"
 )
 
 type Card struct {
@@ -10,23 +11,37 @@ type Card struct {
 	Value
 }
 
-// ParseCard will parse the given string into a card object from short ("ad") or long ("ace of diamonds") forms
+// ParseCard will parse the given string into a card object from short ("ad"), long ("ace of diamonds"),
+// suit symbol ("A♦") or unicode glyph ("🃁") forms
 func ParseCard(str string) (Card, error) {
 	str = strings.ToLower(str)
 
+	// unicode glyph format
+	if card, ok := parseGlyph(str); ok {
+		return card, nil
+	}
+
 	// long format
 	tokens := strings.Split(str, " of ")
 
 	if len(tokens) != 2 {
-		// check if this is short format instead
-		if len(str) != 2 {
-			return Card{}, fmt.Errorf("unexpected card format '%s'", str)
-		}
+		_, size := utf8.DecodeLastRuneInString(str)
 
-		// short fomrat
-		tokens = []string{
-			string(str[0]),
-			string(str[1]),
+		switch {
+		case size > 1 && size < len(str):
+			// suit symbol format
+			tokens = []string{
+				str[:len(str)-size],
+				str[len(str)-size:],
+			}
+		case len(str) == 2:
+			// short format
+			tokens = []string{
+				string(str[0]),
+				string(str[1]),
+			}
+		default:
+			return Card{}, &ParseError{Input: str, As: "card"}
 		}
 	}
 
@@ -22,6 +22,15 @@ func TestParseCard(t *testing.T) {
 	}, {
 		str:      "ah",
 		expected: Card{Value: ValueAce, Suit: SuitHearts},
+	}, {
+		str:      "Q♠",
+		expected: Card{Value: ValueQueen, Suit: SuitSpades},
+	}, {
+		str:      "10♦",
+		expected: Card{Value: ValueTen, Suit: SuitDiamonds},
+	}, {
+		str:      "🃓",
+		expected: Card{Value: ValueThree, Suit: SuitClubs},
 	}}
 
 	for _, test := range successCases {
@@ -42,11 +51,18 @@ func TestParseCardErrors(t *testing.T) {
 		"queen of",
 		"not a card",
 		"king of something",
+		"♠",
+		"x♠",
+		"🂠",
 	}
 
 	for _, test := range failureCases {
 		_, err := ParseCard(test)
-		assert.Error(t, err)
+		assert.ErrorIs(t, err, ErrCardUnparseable)
+		assert.ErrorIs(t, err, ErrUnparseable)
+
+		var parseErr *ParseError
+		assert.ErrorAs(t, err, &parseErr)
 	}
 }
 
@@ -0,0 +1,57 @@
+package game
+
+import (
+	"strings"
+)
+
+// Composition selects the cards that make up a full deck
+type Composition uint8
+
+// Composition values
+const (
+	CompositionStandard    Composition = iota // all 52 cards
+	CompositionPiquet                         // 32 cards from the sevens up (and the aces)
+	CompositionEuchre                         // 24 cards from the nines up (and the aces)
+	CompositionsTotalCount                    // a marker for the end of this enum
+)
+
+// ParseComposition will parse the composition's name (e.g. "piquet")
+func ParseComposition(str string) (Composition, error) {
+	switch strings.ToLower(str) {
+	case "standard":
+		return CompositionStandard, nil
+	case "piquet":
+		return CompositionPiquet, nil
+	case "euchre":
+		return CompositionEuchre, nil
+	default:
+		return 0, &ParseError{Input: str, As: "deck composition"}
+	}
+}
+
+func (c Composition) String() string {
+	return [...]string{
+		"standard",
+		"piquet",
+		"euchre",
+	}[c]
+}
+
+// Contains reports whether the card is a part of the composition
+func (c Composition) Contains(card Card) bool {
+	return card.Value == ValueAce || card.Value >= c.lowest()
+}
+
+// Size returns the number of cards in a full deck
+func (c Composition) Size() int {
+	return int(SuitsTotalCount) * (1 + int(ValuesTotalCount-c.lowest()))
+}
+
+// lowest returns the lowest value in the composition other than the ace
+func (c Composition) lowest() Value {
+	return [...]Value{
+		ValueTwo,
+		ValueSeven,
+		ValueNine,
+	}[c]
+}
@@ -0,0 +1,38 @@
+package game
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseComposition(t *testing.T) {
+	for c := CompositionStandard; c < CompositionsTotalCount; c++ {
+		parsed, err := ParseComposition(c.String())
+		require.NoError(t, err)
+		assert.Equal(t, c, parsed)
+	}
+
+	_, err := ParseComposition("pinochle")
+	assert.ErrorIs(t, err, ErrUnparseable)
+}
+
+func TestCompositionSize(t *testing.T) {
+	assert.Equal(t, 52, CompositionStandard.Size())
+	assert.Equal(t, 32, CompositionPiquet.Size())
+	assert.Equal(t, 24, CompositionEuchre.Size())
+
+	for c := CompositionStandard; c < CompositionsTotalCount; c++ {
+		assert.Len(t, NewDeck(WithComposition(c)).Cards, c.Size(), c.String())
+	}
+}
+
+func TestCompositionContains(t *testing.T) {
+	assert.True(t, CompositionPiquet.Contains(Card{Value: ValueAce, Suit: SuitSpades}))
+	assert.True(t, CompositionPiquet.Contains(Card{Value: ValueSeven, Suit: SuitSpades}))
+	assert.False(t, CompositionPiquet.Contains(Card{Value: ValueSix, Suit: SuitSpades}))
+
+	assert.True(t, CompositionEuchre.Contains(Card{Value: ValueNine, Suit: SuitHearts}))
+	assert.False(t, CompositionEuchre.Contains(Card{Value: ValueEight, Suit: SuitHearts}))
+}
@@ -14,27 +14,62 @@ const (
 type Deck struct {
 	Cards []Card
 
+	// composition is the set of cards this deck is made of
+	composition Composition
+
 	// rng is a random number generator used to shuffle this deck
 	rng *rand.Rand
 }
 
-// NewDeck initializes the deck with 52 unique cards in sorted order
-func NewDeck() *Deck {
-	cards := make([]Card, 0, 52)
+// DeckOption allows setting custom parameters during construction
+type DeckOption func(*Deck)
+
+// WithComposition makes the deck out of the given composition's cards rather than all 52 of them
+func WithComposition(composition Composition) DeckOption {
+	return func(d *Deck) {
+		d.composition = composition
+	}
+}
+
+// WithSeed makes the deck's shuffles reproducible by seeding its random number generator
+func WithSeed(seed int64) DeckOption {
+	return func(d *Deck) {
+		d.rng = rand.New(rand.NewSource(seed))
+	}
+}
+
+// NewDeck initializes the deck with the unique cards of its composition (52 by default) in sorted order
+func NewDeck(opts ...DeckOption) *Deck {
+	d := newDeck(opts)
+
+	d.Cards = make([]Card, 0, d.composition.Size())
 
 	for suit := SuitClubs; suit < SuitsTotalCount; suit++ {
 		for value := ValueAce; value < ValuesTotalCount; value++ {
-			cards = append(cards, Card{
+			card := Card{
 				Value: value,
 				Suit:  suit,
-			})
+			}
+
+			if d.composition.Contains(card) {
+				d.Cards = append(d.Cards, card)
+			}
 		}
 	}
 
-	return &Deck{
-		Cards: cards,
-		rng:   rand.New(rand.NewSource(time.Now().UnixNano())),
+	return d
+}
+
+func newDeck(opts []DeckOption) *Deck {
+	d := &Deck{
+		rng: rand.New(rand.NewSource(time.Now().UnixNano())),
 	}
+
+	for _, o := range opts {
+		o(d)
+	}
+
+	return d
 }
 
 func (d *Deck) Serialize() string {
@@ -47,7 +82,8 @@ func (d *Deck) Serialize() string {
 	return b.String()
 }
 
-func DeckDeserialize(str string) (*Deck, error) {
+// DeckDeserialize parses the cards written by Serialize; the composition is not a part of the string
+func DeckDeserialize(str string, opts ...DeckOption) (*Deck, error) {
 	var cards []Card
 
 	if len(str)%2 != 0 {
@@ -65,16 +101,16 @@ func DeckDeserialize(str string) (*Deck, error) {
 		cards = append(cards, c)
 	}
 
-	return &Deck{
-		Cards: cards,
-		rng:   rand.New(rand.NewSource(time.Now().UnixNano())),
-	}, nil
+	d := newDeck(opts)
+	d.Cards = cards
+
+	return d, nil
 }
 
 // DealCard removes the top card from the deck (subtracts it from the slice's front) and returns it
 func (d *Deck) DealCard() (Card, error) {
 	if len(d.Cards) == 0 {
-		return Card{}, fmt.Errorf("the deck is empty")
+		return Card{}, ErrDeckEmpty
 	}
 
 	top := d.Cards[0]
@@ -87,12 +123,16 @@ func (d *Deck) DealCard() (Card, error) {
 
 // ReturnCard adds the given card to the deck (at the end of the slice)
 func (d *Deck) ReturnCard(card Card) error {
-	if len(d.Cards) >= DeckCapacity {
-		return fmt.Errorf("the deck is full")
+	if len(d.Cards) >= d.composition.Size() {
+		return ErrDeckFull
+	}
+
+	if !d.composition.Contains(card) {
+		return &ForeignCardError{Card: card, Composition: d.composition}
 	}
 
 	if d.find(card) != -1 {
-		return fmt.Errorf("the card '%s' already exists in the deck", card)
+		return &DuplicateCardError{Card: card}
 	}
 
 	d.Cards = append(d.Cards, card)
@@ -100,6 +140,92 @@ func (d *Deck) ReturnCard(card Card) error {
 	return nil
 }
 
+// DealBottom removes the bottom card from the deck (the slice's end) and returns it
+func (d *Deck) DealBottom() (Card, error) {
+	if len(d.Cards) == 0 {
+		return Card{}, ErrDeckEmpty
+	}
+
+	return d.DealAt(len(d.Cards) - 1)
+}
+
+// DealAt removes the card at the given index (0 being the top) and returns it
+func (d *Deck) DealAt(index int) (Card, error) {
+	if len(d.Cards) == 0 {
+		return Card{}, ErrDeckEmpty
+	}
+
+	if index < 0 || index >= len(d.Cards) {
+		return Card{}, &IndexError{Index: index, Len: len(d.Cards)}
+	}
+
+	card := d.Cards[index]
+
+	d.Cards = append(d.Cards[:index], d.Cards[index+1:]...)
+
+	return card, nil
+}
+
+// Draw removes the given card from wherever it is in the deck
+func (d *Deck) Draw(card Card) error {
+	i := d.find(card)
+	if i == -1 {
+		return &MissingCardError{Card: card}
+	}
+
+	_, err := d.DealAt(i)
+
+	return err
+}
+
+// InsertAt adds the given card to the deck at the given index (0 being the top, the deck's size being the bottom)
+func (d *Deck) InsertAt(card Card, index int) error {
+	if index < 0 || index > len(d.Cards) {
+		return &IndexError{Index: index, Len: len(d.Cards) + 1}
+	}
+
+	if err := d.ReturnCard(card); err != nil {
+		return err
+	}
+
+	// the card was appended to the end, move it into its place
+	copy(d.Cards[index+1:], d.Cards[index:len(d.Cards)-1])
+	d.Cards[index] = card
+
+	return nil
+}
+
+// Cut moves the top n cards to the bottom of the deck; both of the packets must not be empty
+func (d *Deck) Cut(n int) error {
+	if len(d.Cards) == 0 {
+		return ErrDeckEmpty
+	}
+
+	if n < 1 || n >= len(d.Cards) {
+		return &IndexError{Index: n, Min: 1, Len: len(d.Cards)}
+	}
+
+	cut := make([]Card, 0, len(d.Cards))
+	cut = append(cut, d.Cards[n:]...)
+
+	d.Cards = append(cut, d.Cards[:n]...)
+
+	return nil
+}
+
+// Peek returns (a copy of) the top n cards without removing them; fewer cards are returned if the deck is shorter
+func (d *Deck) Peek(n int) []Card {
+	if n > len(d.Cards) {
+		n = len(d.Cards)
+	}
+
+	if n <= 0 {
+		return []Card{}
+	}
+
+	return append([]Card(nil), d.Cards[:n]...)
+}
+
 // Shuffle permutes the deck of cards using a pseudo-random algorithm seeded at deck creation time
 func (d *Deck) Shuffle() {
 	// apparently go already has a standard library implementation :)
@@ -108,6 +234,36 @@ func (d *Deck) Shuffle() {
 	})
 }
 
+// Arrange replaces the cards with the given ones, which must be all of the composition's cards in any order
+func (d *Deck) Arrange(cards []Card) error {
+	if len(cards) != d.composition.Size() {
+		return &OrderError{Reason: fmt.Sprintf("%d cards were given, while the %s deck has %d", len(cards), d.composition, d.composition.Size())}
+	}
+
+	seen := make(map[Card]bool, len(cards))
+
+	for _, card := range cards {
+		if !d.composition.Contains(card) {
+			return &OrderError{Reason: fmt.Sprintf("the card '%s' is not a part of the %s deck", card, d.composition)}
+		}
+
+		if seen[card] {
+			return &OrderError{Reason: fmt.Sprintf("the card '%s' is given more than once", card)}
+		}
+
+		seen[card] = true
+	}
+
+	d.Cards = append(d.Cards[:0:0], cards...)
+
+	return nil
+}
+
+// Composition returns the set of cards this deck is made of
+func (d *Deck) Composition() Composition {
+	return d.composition
+}
+
 // Len returns the current deck's size
 func (d *Deck) Len() int {
 	return len(d.Cards)
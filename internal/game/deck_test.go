@@ -25,6 +25,17 @@ func TestDeckShuffle(t *testing.T) {
 	assert.NotEqual(t, original, deck.Cards)
 }
 
+func TestDeckShuffleWithSeed(t *testing.T) {
+	a, b := NewDeck(WithSeed(42)), NewDeck(WithSeed(42))
+
+	a.Shuffle()
+	b.Shuffle()
+
+	// the decks seeded alike are shuffled alike
+	assert.Equal(t, a.Cards, b.Cards)
+	assert.NotEqual(t, NewDeck().Cards, a.Cards)
+}
+
 func TestDeckDeal(t *testing.T) {
 	deck := NewDeck()
 
@@ -51,7 +62,7 @@ func TestDeckDeal(t *testing.T) {
 
 	// the deck is empty; try to deal one more
 	_, err = deck.DealCard()
-	assert.Error(t, err)
+	assert.ErrorIs(t, err, ErrDeckEmpty)
 }
 
 func TestDeckReturn(t *testing.T) {
@@ -62,7 +73,7 @@ func TestDeckReturn(t *testing.T) {
 	copy(original, deck.Cards)
 
 	// the deck is full, try to return one card, expect an error
-	require.Error(t, deck.ReturnCard(Card{Value: ValueQueen, Suit: SuitDiamonds}))
+	require.ErrorIs(t, deck.ReturnCard(Card{Value: ValueQueen, Suit: SuitDiamonds}), ErrDeckFull)
 
 	// deal 5 cards & return them
 	var hand []Card
@@ -87,7 +98,12 @@ func TestDeckReturn(t *testing.T) {
 	// return a card that already exists, expect an error
 	_, err := deck.DealCard()
 	require.NoError(t, err)
-	require.Error(t, deck.ReturnCard(Card{Value: ValueAce, Suit: SuitSpades}))
+	err = deck.ReturnCard(Card{Value: ValueAce, Suit: SuitSpades})
+	require.ErrorIs(t, err, ErrCardDuplicate)
+
+	var duplicate *DuplicateCardError
+	require.ErrorAs(t, err, &duplicate)
+	assert.Equal(t, Card{Value: ValueAce, Suit: SuitSpades}, duplicate.Card)
 }
 
 func TestSerializeDeserialize(t *testing.T) {
@@ -122,3 +138,125 @@ func TestSerializeDeserialize(t *testing.T) {
 	require.Equal(t, expected, deck.Cards)
 	require.Equal(t, "ahqs3djstc", deck.Serialize())
 }
+
+func TestDeckReturnForeignCard(t *testing.T) {
+	deck := NewDeck(WithComposition(CompositionEuchre))
+
+	_, err := deck.DealCard()
+	require.NoError(t, err)
+
+	err = deck.ReturnCard(Card{Value: ValueTwo, Suit: SuitClubs})
+	require.ErrorIs(t, err, ErrCardForeign)
+
+	// the deck is full once all 24 cards are back
+	require.NoError(t, deck.ReturnCard(Card{Value: ValueAce, Suit: SuitClubs}))
+	assert.ErrorIs(t, deck.ReturnCard(Card{Value: ValueTwo, Suit: SuitClubs}), ErrDeckFull)
+}
+
+func TestDeckArrange(t *testing.T) {
+	deck := NewDeck(WithComposition(CompositionEuchre))
+
+	// the reversed deck is a valid arrangement
+	reversed := make([]Card, 0, deck.Len())
+	for i := deck.Len() - 1; i >= 0; i-- {
+		reversed = append(reversed, deck.Cards[i])
+	}
+
+	require.NoError(t, deck.Arrange(reversed))
+	assert.Equal(t, reversed, deck.Cards)
+
+	failureCases := [][]Card{
+		// too few cards
+		reversed[1:],
+		// a duplicate instead of the first card
+		append([]Card{reversed[1]}, reversed[1:]...),
+		// a card from outside of the composition
+		append([]Card{{Value: ValueTwo, Suit: SuitClubs}}, reversed[1:]...),
+	}
+
+	for _, cards := range failureCases {
+		assert.ErrorIs(t, deck.Arrange(cards), ErrOrderInvalid)
+	}
+
+	// the failed arrangements leave the deck untouched
+	assert.Equal(t, reversed, deck.Cards)
+}
+
+func TestDeckDealBottomAt(t *testing.T) {
+	deck, err := DeckDeserialize("ahqs3djstc")
+	require.NoError(t, err)
+
+	card, err := deck.DealBottom()
+	require.NoError(t, err)
+	assert.Equal(t, Card{Value: ValueTen, Suit: SuitClubs}, card)
+
+	card, err = deck.DealAt(1)
+	require.NoError(t, err)
+	assert.Equal(t, Card{Value: ValueQueen, Suit: SuitSpades}, card)
+	assert.Equal(t, "ah3djs", deck.Serialize())
+
+	_, err = deck.DealAt(3)
+	assert.ErrorIs(t, err, ErrIndexOutOfRange)
+	_, err = deck.DealAt(-1)
+	assert.ErrorIs(t, err, ErrIndexOutOfRange)
+
+	for deck.Len() != 0 {
+		_, err := deck.DealBottom()
+		require.NoError(t, err)
+	}
+
+	_, err = deck.DealBottom()
+	assert.ErrorIs(t, err, ErrDeckEmpty)
+	_, err = deck.DealAt(0)
+	assert.ErrorIs(t, err, ErrDeckEmpty)
+}
+
+func TestDeckDrawInsertAt(t *testing.T) {
+	deck, err := DeckDeserialize("ahqs3djstc")
+	require.NoError(t, err)
+
+	threeOfDiamonds := Card{Value: ValueThree, Suit: SuitDiamonds}
+
+	require.NoError(t, deck.Draw(threeOfDiamonds))
+	assert.Equal(t, "ahqsjstc", deck.Serialize())
+
+	var missing *MissingCardError
+	require.ErrorAs(t, deck.Draw(threeOfDiamonds), &missing)
+	assert.ErrorIs(t, missing, ErrCardMissing)
+
+	// the card can go anywhere from the top to the bottom
+	require.NoError(t, deck.InsertAt(threeOfDiamonds, 0))
+	assert.Equal(t, "3dahqsjstc", deck.Serialize())
+
+	require.NoError(t, deck.Draw(threeOfDiamonds))
+	require.NoError(t, deck.InsertAt(threeOfDiamonds, 2))
+	assert.Equal(t, "ahqs3djstc", deck.Serialize())
+
+	require.NoError(t, deck.Draw(threeOfDiamonds))
+	require.NoError(t, deck.InsertAt(threeOfDiamonds, 4))
+	assert.Equal(t, "ahqsjstc3d", deck.Serialize())
+
+	// the same checks as for the returned cards apply
+	assert.ErrorIs(t, deck.InsertAt(threeOfDiamonds, 0), ErrCardDuplicate)
+	assert.ErrorIs(t, deck.InsertAt(Card{Value: ValueTwo, Suit: SuitClubs}, 6), ErrIndexOutOfRange)
+	assert.ErrorIs(t, NewDeck().InsertAt(Card{Value: ValueTwo, Suit: SuitClubs}, 0), ErrDeckFull)
+	assert.Equal(t, "ahqsjstc3d", deck.Serialize())
+}
+
+func TestDeckCutPeek(t *testing.T) {
+	deck, err := DeckDeserialize("ahqs3djstc")
+	require.NoError(t, err)
+
+	assert.Equal(t, []Card{{Value: ValueAce, Suit: SuitHearts}, {Value: ValueQueen, Suit: SuitSpades}}, deck.Peek(2))
+	assert.Len(t, deck.Peek(10), 5)
+	assert.Empty(t, deck.Peek(0))
+
+	require.NoError(t, deck.Cut(2))
+	assert.Equal(t, "3djstcahqs", deck.Serialize())
+
+	// both of the packets must not be empty
+	assert.ErrorIs(t, deck.Cut(0), ErrIndexOutOfRange)
+	assert.EqualError(t, deck.Cut(0), "the index 0 is out of the deck's range [1, 5)")
+	assert.ErrorIs(t, deck.Cut(5), ErrIndexOutOfRange)
+	assert.ErrorIs(t, new(Deck).Cut(1), ErrDeckEmpty)
+}
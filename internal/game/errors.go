@@ -0,0 +1,119 @@
+package game
+
+import (
+	"errors"
+	"fmt"
+)
+
+// Sentinel errors that can be matched with errors.Is
+var (
+	ErrDeckEmpty       = errors.New("the deck is empty")
+	ErrDeckFull        = errors.New("the deck is full")
+	ErrCardDuplicate   = errors.New("the card already exists in the deck")
+	ErrCardUnparseable = errors.New("the card could not be parsed")
+	ErrUnparseable     = errors.New("the input could not be parsed")
+	ErrCardForeign     = errors.New("the card is not a part of the deck's composition")
+	ErrOrderInvalid    = errors.New("the order is not an arrangement of the deck's cards")
+	ErrCardMissing     = errors.New("the card is not in the deck")
+	ErrIndexOutOfRange = errors.New("the index is out of the deck's range")
+	ErrDeckShort       = errors.New("the deck has fewer cards than are needed")
+)
+
+// DuplicateCardError is returned when a card that is already in the deck is added to it again
+type DuplicateCardError struct {
+	Card Card
+}
+
+func (e *DuplicateCardError) Error() string {
+	return fmt.Sprintf("the card '%s' already exists in the deck", e.Card)
+}
+
+// Is makes errors.Is(err, ErrCardDuplicate) succeed
+func (e *DuplicateCardError) Is(target error) bool {
+	return target == ErrCardDuplicate
+}
+
+// MissingCardError is returned when a card that is not in the deck is drawn from it
+type MissingCardError struct {
+	Card Card
+}
+
+func (e *MissingCardError) Error() string {
+	return fmt.Sprintf("the card '%s' is not in the deck", e.Card)
+}
+
+// Is makes errors.Is(err, ErrCardMissing) succeed
+func (e *MissingCardError) Is(target error) bool {
+	return target == ErrCardMissing
+}
+
+// IndexError is returned when a position in the deck is outside of [Min, Len)
+type IndexError struct {
+	Index int
+	Min   int // the lowest valid index, i.e. 0 unless the operation needs some cards above the index
+	Len   int
+}
+
+func (e *IndexError) Error() string {
+	return fmt.Sprintf("the index %d is out of the deck's range [%d, %d)", e.Index, e.Min, e.Len)
+}
+
+// Is makes errors.Is(err, ErrIndexOutOfRange) succeed
+func (e *IndexError) Is(target error) bool {
+	return target == ErrIndexOutOfRange
+}
+
+// ForeignCardError is returned when a card that is not a part of the deck's composition is added to it
+type ForeignCardError struct {
+	Card        Card
+	Composition Composition
+}
+
+func (e *ForeignCardError) Error() string {
+	return fmt.Sprintf("the card '%s' is not a part of the %s deck", e.Card, e.Composition)
+}
+
+// Is makes errors.Is(err, ErrCardForeign) succeed
+func (e *ForeignCardError) Is(target error) bool {
+	return target == ErrCardForeign
+}
+
+// OrderError is returned when the cards given to Arrange are not a full deck of the composition
+type OrderError struct {
+	Reason string
+}
+
+func (e *OrderError) Error() string {
+	return fmt.Sprintf("the order is invalid: %s", e.Reason)
+}
+
+// Is makes errors.Is(err, ErrOrderInvalid) succeed
+func (e *OrderError) Is(target error) bool {
+	return target == ErrOrderInvalid
+}
+
+// ParseError is returned when a string cannot be parsed as a card, a suit or a card value, or as any other input
+// (e.g. a card order or a deck composition)
+type ParseError struct {
+	Input string // the string that could not be parsed
+	As    string // what the string was parsed as ("card", "suit", "card value", "card order", ...)
+}
+
+func (e *ParseError) Error() string {
+	return fmt.Sprintf("could not parse '%s' as %s", e.Input, e.As)
+}
+
+// Is makes errors.Is(err, ErrUnparseable) succeed, as well as errors.Is(err, ErrCardUnparseable) for the cards
+func (e *ParseError) Is(target error) bool {
+	return target == ErrUnparseable || target == ErrCardUnparseable && e.IsCard()
+}
+
+// IsCard tells whether the string was parsed as a card or a part of one
+func (e *ParseError) IsCard() bool {
+	switch e.As {
+	case "card", "suit", "card value", "csv":
+		return true
+	default:
+		return false
+	}
+}
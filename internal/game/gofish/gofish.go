@@ -0,0 +1,572 @@
+// Package gofish implements go fish for 2 to 6 players: the players ask each other for the values they hold,
+// go fishing in the stock when the asked player has none & lay down the books of all four cards of a value
+package gofish
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/AntonAverchenkov/cards-http-service/internal/game"
+)
+
+const (
+	// MinPlayers & MaxPlayers bound the number of the players
+	MinPlayers = 2
+	MaxPlayers = 6
+
+	// MaxTurns ends the game, since the players may keep asking for the values nobody else holds
+	MaxTurns = 10000
+
+	// recentTurns is the number of the last turns persisted along with the ones the bots need (see persistedLog)
+	recentTurns = 64
+
+	// NoTarget marks the turns in which a player with an empty hand draws a card instead of asking
+	NoTarget = -1
+
+	// bookSize is the number of the cards of a value making up a book
+	bookSize = int(game.SuitsTotalCount)
+
+	// separator separates the current player, the stock, the turns & the players in the serialized game
+	separator = "/"
+
+	// playedSeparator separates the current player & the number of the turns played in the serialized game
+	playedSeparator = "."
+)
+
+// Sentinel errors that can be matched with errors.Is
+var (
+	ErrMoveIllegal    = errors.New("the move is not allowed")
+	ErrPlayersInvalid = errors.New("the players cannot sit at the table")
+	ErrGameOver       = errors.New("the game is over")
+	ErrPlayerNotFound = errors.New("there is no such player in the game")
+)
+
+// playerName keeps the names safe to be written into the session persistence file
+var playerName = regexp.MustCompile(`^[A-Za-z0-9_-]{1,16}$`)
+
+// Status is the state of the game
+type Status uint8
+
+// Status values
+const (
+	StatusInProgress Status = iota
+	StatusOver
+)
+
+func (s Status) String() string {
+	return [...]string{
+		"in_progress",
+		"over",
+	}[s]
+}
+
+// Player is a seat at the table
+type Player struct {
+	Name string
+
+	// Bot plays the player's turns, nil for the players asking through the api
+	Bot Strategy
+
+	Hand  []game.Card
+	Books []game.Value
+}
+
+// Turn is an ask & its outcome, all of which is seen by every player
+type Turn struct {
+	Player int
+
+	// Target is the asked player, NoTarget when the player had no cards to ask with & drew one
+	Target int
+	Value  game.Value
+
+	// Got is the number of the cards handed over by the target
+	Got int
+
+	// Drawn is the number of the cards drawn from the stock
+	Drawn int
+
+	// Again is set when the player goes on: the target had the value or the card fished was of it
+	Again bool
+
+	// Books are the values the player laid down
+	Books []game.Value
+}
+
+// Game is the state of a game
+type Game struct {
+	Players []Player
+
+	// Stock holds the cards left to draw from the top (index 0) to the bottom
+	Stock []game.Card
+
+	// Current is the player whose turn it is
+	Current int
+
+	// Played is the number of the turns played, which ends the game at MaxTurns
+	Played int
+
+	// Log holds the turns played; a restored game holds only the turns that were persisted (see persistedLog)
+	Log []Turn
+}
+
+// New deals 7 cards to each of 2 or 3 players & 5 cards to each of more players one at a time; the rest of the
+// cards become the stock. The players need only the names & the bots set, and the books dealt are laid down.
+func New(cards []game.Card, players []Player) (*Game, error) {
+	if len(players) < MinPlayers || len(players) > MaxPlayers {
+		return nil, fmt.Errorf("%w: go fish needs %d to %d players, got %d", ErrPlayersInvalid, MinPlayers, MaxPlayers, len(players))
+	}
+
+	names := make(map[string]bool, len(players))
+	for _, p := range players {
+		if !playerName.MatchString(p.Name) {
+			return nil, &game.ParseError{Input: p.Name, As: "player name"}
+		}
+
+		if names[p.Name] {
+			return nil, fmt.Errorf("%w: the name '%s' is taken", ErrPlayersInvalid, p.Name)
+		}
+
+		names[p.Name] = true
+	}
+
+	var counts [game.ValuesTotalCount]int
+	for _, card := range cards {
+		counts[card.Value]++
+	}
+
+	for _, count := range counts {
+		if count != 0 && count != bookSize {
+			return nil, fmt.Errorf("%w: go fish needs all %d cards of every value", game.ErrDeckShort, bookSize)
+		}
+	}
+
+	handSize := 7
+	if len(players) > 3 {
+		handSize = 5
+	}
+
+	if len(cards) < handSize*len(players) {
+		return nil, fmt.Errorf("%w: go fish needs %d cards for %d players, got %d", game.ErrDeckShort, handSize*len(players), len(players), len(cards))
+	}
+
+	g := &Game{Players: make([]Player, len(players))}
+
+	for i, p := range players {
+		g.Players[i] = Player{Name: p.Name, Bot: p.Bot}
+	}
+
+	for i, card := range cards[:handSize*len(players)] {
+		p := &g.Players[i%len(players)]
+		p.Hand = append(p.Hand, card)
+	}
+
+	for i := range g.Players {
+		g.layBooks(i)
+	}
+
+	g.Stock = append([]game.Card(nil), cards[handSize*len(players):]...)
+	g.settle()
+
+	return g, nil
+}
+
+// Find returns the index of the named player
+func (g *Game) Find(name string) (int, error) {
+	for i, p := range g.Players {
+		if p.Name == name {
+			return i, nil
+		}
+	}
+
+	return 0, fmt.Errorf("%w: '%s'", ErrPlayerNotFound, name)
+}
+
+// Status reports whether all of the books are laid down or the turns ran out
+func (g *Game) Status() Status {
+	if g.Played >= MaxTurns || len(g.Stock) == 0 && g.handsEmpty() {
+		return StatusOver
+	}
+
+	return StatusInProgress
+}
+
+// Winners returns the players with the most books once the game is over
+func (g *Game) Winners() []int {
+	if g.Status() != StatusOver {
+		return nil
+	}
+
+	var (
+		winners []int
+		most    int
+	)
+
+	for i, p := range g.Players {
+		switch {
+		case len(p.Books) > most:
+			winners, most = []int{i}, len(p.Books)
+		case len(p.Books) == most:
+			winners = append(winners, i)
+		}
+	}
+
+	return winners
+}
+
+// Ask plays the current player's turn: the target hands over all of the cards of the value, or else the player
+// goes fishing. The player goes on after getting the value & the turn passes to the next player otherwise.
+func (g *Game) Ask(player, target int, value game.Value) (Turn, error) {
+	if g.Status() != StatusInProgress {
+		return Turn{}, ErrGameOver
+	}
+
+	if err := g.validate(player, target, value); err != nil {
+		return Turn{}, err
+	}
+
+	turn := Turn{Player: player, Target: target, Value: value}
+
+	asker, asked := &g.Players[player], &g.Players[target]
+
+	var kept []game.Card
+	for _, card := range asked.Hand {
+		if card.Value == value {
+			asker.Hand = append(asker.Hand, card)
+			turn.Got++
+		} else {
+			kept = append(kept, card)
+		}
+	}
+	asked.Hand = kept
+
+	switch {
+	case turn.Got != 0:
+		turn.Again = true
+	case len(g.Stock) != 0:
+		card := g.draw(player)
+		turn.Drawn = 1
+		turn.Again = card.Value == value
+	}
+
+	turn.Books = g.layBooks(player)
+
+	if !turn.Again {
+		g.Current = g.next(player)
+	}
+
+	g.log(turn)
+	g.settle()
+
+	return turn, nil
+}
+
+func (g *Game) validate(player, target int, value game.Value) error {
+	if player != g.Current {
+		return fmt.Errorf("%w: it is %s's turn", ErrMoveIllegal, g.Players[g.Current].Name)
+	}
+
+	if target == player || target < 0 || target >= len(g.Players) {
+		return fmt.Errorf("%w: %s has to ask another player", ErrMoveIllegal, g.Players[player].Name)
+	}
+
+	for _, card := range g.Players[player].Hand {
+		if card.Value == value {
+			return nil
+		}
+	}
+
+	return fmt.Errorf("%w: %s has to hold a card of the value %s asked for", ErrMoveIllegal, g.Players[player].Name, value)
+}
+
+// PlayBots plays the bots' turns until it is the turn of a player asking through the api or the game is over
+func (g *Game) PlayBots() error {
+	for g.Status() == StatusInProgress && g.Players[g.Current].Bot != nil {
+		player := g.Current
+
+		target, value := g.Players[player].Bot.Ask(g.View(player))
+		if _, err := g.Ask(player, target, value); err != nil {
+			return fmt.Errorf("the bot %s of %s made a wrong move: %w", g.Players[player].Bot.Name(), g.Players[player].Name, err)
+		}
+	}
+
+	return nil
+}
+
+// settle passes the turn over the players without cards once the stock is empty & lets a player with an empty
+// hand draw a card to ask with
+func (g *Game) settle() {
+	for g.Status() == StatusInProgress {
+		switch {
+		case len(g.Players[g.Current].Hand) != 0:
+			return
+		case len(g.Stock) == 0:
+			g.Current = g.next(g.Current)
+		default:
+			g.draw(g.Current)
+			g.log(Turn{Player: g.Current, Target: NoTarget, Drawn: 1, Again: true})
+		}
+	}
+}
+
+func (g *Game) log(turn Turn) {
+	g.Log = append(g.Log, turn)
+	g.Played++
+}
+
+func (g *Game) draw(player int) game.Card {
+	card := g.Stock[0]
+	g.Stock = g.Stock[1:]
+
+	p := &g.Players[player]
+	p.Hand = append(p.Hand, card)
+
+	return card
+}
+
+// layBooks lays down the player's books & returns their values
+func (g *Game) layBooks(player int) []game.Value {
+	p := &g.Players[player]
+
+	var counts [game.ValuesTotalCount]int
+	for _, card := range p.Hand {
+		counts[card.Value]++
+	}
+
+	var books []game.Value
+	for v, count := range counts {
+		if count == bookSize {
+			books = append(books, game.Value(v))
+		}
+	}
+
+	if len(books) == 0 {
+		return nil
+	}
+
+	var kept []game.Card
+	for _, card := range p.Hand {
+		if counts[card.Value] != bookSize {
+			kept = append(kept, card)
+		}
+	}
+
+	p.Hand = kept
+	p.Books = append(p.Books, books...)
+
+	return books
+}
+
+func (g *Game) next(player int) int {
+	return (player + 1) % len(g.Players)
+}
+
+func (g *Game) handsEmpty() bool {
+	for _, p := range g.Players {
+		if len(p.Hand) != 0 {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Cards returns all of the cards in the game: the players' books (by suit) & hands, then the stock
+func (g *Game) Cards() []game.Card {
+	var cards []game.Card
+
+	for _, p := range g.Players {
+		for _, v := range p.Books {
+			for s := game.Suit(0); s < game.SuitsTotalCount; s++ {
+				cards = append(cards, game.Card{Suit: s, Value: v})
+			}
+		}
+
+		cards = append(cards, p.Hand...)
+	}
+
+	return append(cards, g.Stock...)
+}
+
+// Serialize encodes the game as 'current.played/stock/turns/players...' with the cards & the values in the short
+// form; the turns (only those of persistedLog) are separated by ',' & written as
+// 'player.target.value.got.drawn.again.books', while the players are written as 'name:bot:hand:books' with an
+// empty bot for the players asking through the api
+func (g *Game) Serialize() string {
+	log := g.persistedLog()
+
+	turns := make([]string, 0, len(log))
+	for _, t := range log {
+		turns = append(turns, strings.Join([]string{
+			strconv.Itoa(t.Player),
+			strconv.Itoa(t.Target),
+			t.Value.ShortString(),
+			strconv.Itoa(t.Got),
+			strconv.Itoa(t.Drawn),
+			strconv.FormatBool(t.Again),
+			serializeValues(t.Books),
+		}, "."))
+	}
+
+	tokens := []string{
+		strconv.Itoa(g.Current) + playedSeparator + strconv.Itoa(g.Played),
+		serializeCards(g.Stock),
+		strings.Join(turns, ","),
+	}
+
+	for _, p := range g.Players {
+		var bot string
+		if p.Bot != nil {
+			bot = p.Bot.Name()
+		}
+
+		tokens = append(tokens, strings.Join([]string{p.Name, bot, serializeCards(p.Hand), serializeValues(p.Books)}, ":"))
+	}
+
+	return strings.Join(tokens, separator)
+}
+
+// Deserialize decodes a game written by Serialize
+func Deserialize(str string) (*Game, error) {
+	tokens := strings.Split(str, separator)
+	if len(tokens) < 3+MinPlayers || len(tokens) > 3+MaxPlayers {
+		return nil, fmt.Errorf("incorrect number of tokens in the go fish game %q", str)
+	}
+
+	g := &Game{Players: make([]Player, len(tokens)-3)}
+
+	var err error
+
+	// the games persisted before the turns were counted have their whole logs
+	current, played, counted := strings.Cut(tokens[0], playedSeparator)
+
+	if g.Current, err = strconv.Atoi(current); err != nil || g.Current < 0 || g.Current >= len(g.Players) {
+		return nil, fmt.Errorf("could not parse the go fish game's current player %q", tokens[0])
+	}
+
+	if g.Stock, err = deserializeCards(tokens[1]); err != nil {
+		return nil, err
+	}
+
+	if tokens[2] != "" {
+		for _, token := range strings.Split(tokens[2], ",") {
+			t, err := deserializeTurn(token)
+			if err != nil {
+				return nil, err
+			}
+
+			g.Log = append(g.Log, t)
+		}
+	}
+
+	g.Played = len(g.Log)
+	if counted {
+		if g.Played, err = strconv.Atoi(played); err != nil || g.Played < len(g.Log) {
+			return nil, fmt.Errorf("could not parse the go fish game's number of turns %q", tokens[0])
+		}
+	}
+
+	for i, token := range tokens[3:] {
+		fields := strings.Split(token, ":")
+		if len(fields) != 4 {
+			return nil, fmt.Errorf("could not parse the go fish game's player %q", token)
+		}
+
+		p := &g.Players[i]
+		p.Name = fields[0]
+
+		if fields[1] != "" {
+			if p.Bot, err = ParseStrategy(fields[1]); err != nil {
+				return nil, fmt.Errorf("could not parse the go fish game's player %q: %w", token, err)
+			}
+		}
+
+		if p.Hand, err = deserializeCards(fields[2]); err != nil {
+			return nil, err
+		}
+
+		if p.Books, err = deserializeValues(fields[3]); err != nil {
+			return nil, err
+		}
+	}
+
+	return g, nil
+}
+
+func deserializeTurn(str string) (Turn, error) {
+	fields := strings.Split(str, ".")
+	if len(fields) != 7 {
+		return Turn{}, fmt.Errorf("could not parse the go fish game's turn %q", str)
+	}
+
+	var (
+		t    Turn
+		errs [6]error
+	)
+
+	t.Player, errs[0] = strconv.Atoi(fields[0])
+	t.Target, errs[1] = strconv.Atoi(fields[1])
+	t.Value, errs[2] = game.ParseValue(fields[2])
+	t.Got, errs[3] = strconv.Atoi(fields[3])
+	t.Drawn, errs[4] = strconv.Atoi(fields[4])
+	t.Again, errs[5] = strconv.ParseBool(fields[5])
+
+	for _, err := range errs {
+		if err != nil {
+			return Turn{}, fmt.Errorf("could not parse the go fish game's turn %q: %w", str, err)
+		}
+	}
+
+	books, err := deserializeValues(fields[6])
+	if err != nil {
+		return Turn{}, err
+	}
+
+	t.Books = books
+
+	return t, nil
+}
+
+func serializeCards(cards []game.Card) string {
+	return (&game.Deck{Cards: cards}).Serialize()
+}
+
+func deserializeCards(str string) ([]game.Card, error) {
+	deck, err := game.DeckDeserialize(str)
+	if err != nil {
+		return nil, fmt.Errorf("could not parse the go fish game: %w", err)
+	}
+
+	if len(deck.Cards) == 0 {
+		return nil, nil
+	}
+
+	return deck.Cards, nil
+}
+
+func serializeValues(values []game.Value) string {
+	var b strings.Builder
+
+	for _, v := range values {
+		b.WriteString(v.ShortString())
+	}
+
+	return b.String()
+}
+
+func deserializeValues(str string) ([]game.Value, error) {
+	var values []game.Value
+
+	for _, r := range str {
+		v, err := game.ParseValue(string(r))
+		if err != nil {
+			return nil, fmt.Errorf("could not parse the go fish game: %w", err)
+		}
+
+		values = append(values, v)
+	}
+
+	return values, nil
+}
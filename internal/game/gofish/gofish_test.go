@@ -0,0 +1,285 @@
+package gofish
+
+import (
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/AntonAverchenkov/cards-http-service/internal/game"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func cards(t *testing.T, str string) []game.Card {
+	t.Helper()
+
+	deck, err := game.DeckDeserialize(str)
+	require.NoError(t, err)
+
+	return deck.Cards
+}
+
+func serialize(cards []game.Card) string {
+	return (&game.Deck{Cards: cards}).Serialize()
+}
+
+func players(names ...string) []Player {
+	result := make([]Player, 0, len(names))
+	for _, name := range names {
+		result = append(result, Player{Name: name})
+	}
+
+	return result
+}
+
+func TestNew(t *testing.T) {
+	g, err := New(game.NewDeck().Cards, players("ann", "bob"))
+	require.NoError(t, err)
+
+	// the new deck is ordered by suit, so the hands alternate between the values
+	assert.Equal(t, "ac3c5c7c9cjckc", serialize(g.Players[0].Hand))
+	assert.Equal(t, "2c4c6c8ctcqcah", serialize(g.Players[1].Hand))
+	assert.Len(t, g.Stock, 52-14)
+	assert.Equal(t, StatusInProgress, g.Status())
+
+	g, err = New(game.NewDeck().Cards, players("a", "b", "c", "d"))
+	require.NoError(t, err)
+	assert.Len(t, g.Players[3].Hand, 5)
+
+	_, err = New(game.NewDeck().Cards, players("ann"))
+	assert.ErrorIs(t, err, ErrPlayersInvalid)
+
+	_, err = New(game.NewDeck().Cards, players("ann", "ann"))
+	assert.ErrorIs(t, err, ErrPlayersInvalid)
+
+	_, err = New(game.NewDeck().Cards, players("ann", "b o b"))
+	assert.ErrorIs(t, err, game.ErrUnparseable)
+
+	_, err = New(game.NewDeck().Cards[1:], players("ann", "bob"))
+	assert.ErrorIs(t, err, game.ErrDeckShort)
+}
+
+func TestNewLaysBooks(t *testing.T) {
+	// ann is dealt the four aces & bob the four twos
+	g, err := New(cards(t, "ac2cah2had2das2s3c3h3d3s4c4h4d4s"), players("ann", "bob"))
+	require.NoError(t, err)
+
+	assert.Equal(t, []game.Value{game.ValueAce}, g.Players[0].Books)
+	assert.Equal(t, "3c3d4c", serialize(g.Players[0].Hand))
+	assert.Equal(t, []game.Value{game.ValueTwo}, g.Players[1].Books)
+	assert.Equal(t, "3h3s4h", serialize(g.Players[1].Hand))
+}
+
+func TestAsk(t *testing.T) {
+	// ann: ac ad 2c 3c 4c 4d 4h ; bob: ah as 2d 2h 3h 3d 3s ; stock: 2s 4s
+	g, err := New(cards(t, "acahadas2c2d3c2h4c3h4d3d4h3s2s4s"), players("ann", "bob"))
+	require.NoError(t, err)
+
+	_, err = g.Ask(1, 0, game.ValueTwo)
+	assert.ErrorIs(t, err, ErrMoveIllegal)
+
+	_, err = g.Ask(0, 0, game.ValueAce)
+	assert.ErrorIs(t, err, ErrMoveIllegal)
+
+	_, err = g.Ask(0, 1, game.ValueKing)
+	assert.ErrorIs(t, err, ErrMoveIllegal)
+
+	// bob hands over his aces, ann lays down the book & goes on
+	turn, err := g.Ask(0, 1, game.ValueAce)
+	require.NoError(t, err)
+	assert.Equal(t, Turn{Player: 0, Target: 1, Value: game.ValueAce, Got: 2, Again: true, Books: []game.Value{game.ValueAce}}, turn)
+	assert.Equal(t, "2c3c4c4d4h", serialize(g.Players[0].Hand))
+	assert.Equal(t, 0, g.Current)
+
+	// go fish: the card fished is not a four, so the turn passes
+	turn, err = g.Ask(0, 1, game.ValueFour)
+	require.NoError(t, err)
+	assert.Equal(t, Turn{Player: 0, Target: 1, Value: game.ValueFour, Drawn: 1}, turn)
+	assert.Equal(t, "2c3c4c4d4h2s", serialize(g.Players[0].Hand))
+	assert.Equal(t, "4s", serialize(g.Stock))
+	assert.Equal(t, 1, g.Current)
+
+	_, err = g.Ask(1, 0, game.ValueThree)
+	require.NoError(t, err)
+
+	// bob lays down the twos & draws the last card into his empty hand
+	turn, err = g.Ask(1, 0, game.ValueTwo)
+	require.NoError(t, err)
+	assert.Equal(t, []game.Value{game.ValueTwo}, turn.Books)
+	assert.Equal(t, Turn{Player: 1, Target: NoTarget, Drawn: 1, Again: true}, g.Log[len(g.Log)-1])
+	assert.Equal(t, "4s", serialize(g.Players[1].Hand))
+	assert.Empty(t, g.Stock)
+
+	assert.Equal(t, StatusInProgress, g.Status())
+	assert.Nil(t, g.Winners())
+
+	_, err = g.Ask(1, 0, game.ValueFour)
+	require.NoError(t, err)
+
+	assert.Equal(t, StatusOver, g.Status())
+	assert.Equal(t, []game.Value{game.ValueThree, game.ValueTwo, game.ValueFour}, g.Players[1].Books)
+	assert.Equal(t, []int{1}, g.Winners())
+
+	_, err = g.Ask(1, 0, game.ValueFour)
+	assert.ErrorIs(t, err, ErrGameOver)
+
+	assert.Len(t, g.Cards(), 16)
+}
+
+func TestFind(t *testing.T) {
+	g, err := New(game.NewDeck().Cards, players("ann", "bob"))
+	require.NoError(t, err)
+
+	i, err := g.Find("bob")
+	require.NoError(t, err)
+	assert.Equal(t, 1, i)
+
+	_, err = g.Find("cid")
+	assert.ErrorIs(t, err, ErrPlayerNotFound)
+}
+
+func TestView(t *testing.T) {
+	g, err := New(game.NewDeck().Cards, players("ann", "bob", "cid"))
+	require.NoError(t, err)
+
+	v := g.View(1)
+	assert.Equal(t, g.Players[1].Hand, v.Hand)
+	assert.Equal(t, 7, v.Players[0].Cards)
+	assert.Equal(t, 52-21, v.Stock)
+
+	assert.Nil(t, g.View(NoTarget).Hand)
+}
+
+func TestKnowledge(t *testing.T) {
+	v := View{
+		Players: []Opponent{{Cards: 3}, {Cards: 3}},
+		Log: []Turn{
+			{Player: 0, Target: 1, Value: game.ValueAce, Drawn: 1},
+			{Player: 1, Target: 0, Value: game.ValueTwo, Got: 1, Again: true},
+		},
+	}
+
+	// ann asked for an ace, so she holds one & bob does not
+	assert.True(t, v.Holds(0, game.ValueAce))
+	assert.True(t, v.Lacks(1, game.ValueAce))
+
+	// ann handed over her twos
+	assert.True(t, v.Lacks(0, game.ValueTwo))
+	assert.True(t, v.Holds(1, game.ValueTwo))
+
+	// bob drew a card, which may be an ace
+	v.Log = append(v.Log, Turn{Player: 1, Target: 0, Value: game.ValueThree, Drawn: 1})
+	assert.False(t, v.Lacks(1, game.ValueAce))
+}
+
+func TestPlayBots(t *testing.T) {
+	for _, bot := range Strategies {
+		g, err := New(game.NewDeck().Cards, []Player{{Name: "ann", Bot: bot}, {Name: "bob", Bot: Basic{}}, {Name: "cid", Bot: Memory{}}})
+		require.NoError(t, err)
+
+		require.NoError(t, g.PlayBots(), bot.Name())
+		assert.Equal(t, StatusOver, g.Status(), bot.Name())
+		assert.Less(t, len(g.Log), MaxTurns, bot.Name())
+
+		books := 0
+		for _, p := range g.Players {
+			books += len(p.Books)
+		}
+		assert.Equal(t, int(game.ValuesTotalCount), books, bot.Name())
+	}
+
+	// the bots stop at the turn of a player asking through the api
+	g, err := New(game.NewDeck().Cards, []Player{{Name: "ann", Bot: Basic{}}, {Name: "bob"}})
+	require.NoError(t, err)
+
+	require.NoError(t, g.PlayBots())
+	assert.Equal(t, 1, g.Current)
+	assert.NotEmpty(t, g.Log)
+}
+
+func TestSerialize(t *testing.T) {
+	g, err := New(game.NewDeck().Cards, []Player{{Name: "ann"}, {Name: "bob", Bot: Memory{}}})
+	require.NoError(t, err)
+
+	_, err = g.Ask(0, 1, game.ValueAce)
+	require.NoError(t, err)
+	require.NoError(t, g.PlayBots())
+
+	restored, err := Deserialize(g.Serialize())
+	require.NoError(t, err)
+	assert.Equal(t, g.Serialize(), restored.Serialize())
+	assert.Equal(t, Memory{}, restored.Players[1].Bot)
+	assert.Len(t, restored.Log, len(g.Log))
+
+	_, err = Deserialize("0//")
+	assert.Error(t, err)
+
+	_, err = Deserialize("0///ann:robot::/bob:::")
+	assert.ErrorIs(t, err, game.ErrUnparseable)
+}
+
+// stubborn asks for a value a player is sure to lack whenever there is one, so that the game drags on
+func stubborn(g *Game) (int, game.Value) {
+	player := g.Current
+
+	for _, card := range g.Players[player].Hand {
+		for target := range g.Players {
+			if target == player {
+				continue
+			}
+
+			lacks := true
+			for _, held := range g.Players[target].Hand {
+				if held.Value == card.Value {
+					lacks = false
+				}
+			}
+
+			if lacks {
+				return target, card.Value
+			}
+		}
+	}
+
+	return g.next(player), g.Players[player].Hand[0].Value
+}
+
+func TestSerializeLongGame(t *testing.T) {
+	const played = 5000
+
+	g, err := New(game.NewDeck().Cards, []Player{{Name: "ann"}, {Name: "bob", Bot: Memory{}}, {Name: "cid"}})
+	require.NoError(t, err)
+
+	for g.Played < played {
+		require.Equal(t, StatusInProgress, g.Status())
+
+		target, value := stubborn(g)
+		_, err := g.Ask(g.Current, target, value)
+		require.NoError(t, err)
+	}
+
+	// only the turns the bots need & the recent ones are persisted
+	str := g.Serialize()
+	assert.Less(t, len(str), 8*1024)
+
+	restored, err := Deserialize(str)
+	require.NoError(t, err)
+	assert.Equal(t, played, restored.Played)
+	assert.Equal(t, g.Log[len(g.Log)-recentTurns:], restored.Log[len(restored.Log)-recentTurns:])
+
+	// the bots know as much as before the restart
+	want, got := g.View(1), restored.View(1)
+	for player := range g.Players {
+		for value := game.Value(0); value < game.ValuesTotalCount; value++ {
+			assert.Equal(t, want.Holds(player, value), got.Holds(player, value), "%d holds %s", player, value)
+			assert.Equal(t, want.Lacks(player, value), got.Lacks(player, value), "%d lacks %s", player, value)
+		}
+	}
+
+	// the games persisted before the turns were counted keep the whole logs
+	_, rest, _ := strings.Cut(str, separator)
+
+	legacy, err := Deserialize(strconv.Itoa(g.Current) + separator + rest)
+	require.NoError(t, err)
+	assert.Equal(t, len(legacy.Log), legacy.Played)
+}
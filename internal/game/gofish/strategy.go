@@ -0,0 +1,274 @@
+package gofish
+
+import (
+	"sort"
+
+	"github.com/AntonAverchenkov/cards-http-service/internal/game"
+)
+
+// Strategy picks the asks of a bot
+type Strategy interface {
+	// Name identifies the strategy in the api & the persisted games
+	Name() string
+
+	// Ask returns the player to ask & the value to ask for, which the bot has to hold
+	Ask(v View) (target int, value game.Value)
+}
+
+// Strategies are the bots by their names; a new bot is plugged in by adding it here
+var Strategies = map[string]Strategy{
+	Basic{}.Name():  Basic{},
+	Memory{}.Name(): Memory{},
+}
+
+// ParseStrategy will parse the name of a bot
+func ParseStrategy(str string) (Strategy, error) {
+	s, ok := Strategies[str]
+	if !ok {
+		return nil, &game.ParseError{Input: str, As: "bot"}
+	}
+
+	return s, nil
+}
+
+// Opponent is what every player knows about another one
+type Opponent struct {
+	Name  string
+	Bot   Strategy
+	Cards int
+	Books []game.Value
+}
+
+// View is the game as seen by one of the players: the other hands are hidden
+type View struct {
+	// Player is the viewing player, NoTarget for a spectator
+	Player int
+
+	// Hand is the viewing player's hand (nil for a spectator)
+	Hand []game.Card
+
+	// Players are all of the players, the viewing one included
+	Players []Opponent
+
+	Stock   int
+	Current int
+	Status  Status
+	Log     []Turn
+}
+
+// View returns the game as seen by the player, or by a spectator for NoTarget
+func (g *Game) View(player int) View {
+	v := View{
+		Player:  player,
+		Players: make([]Opponent, 0, len(g.Players)),
+		Stock:   len(g.Stock),
+		Current: g.Current,
+		Status:  g.Status(),
+		Log:     g.Log,
+	}
+
+	for i, p := range g.Players {
+		v.Players = append(v.Players, Opponent{Name: p.Name, Bot: p.Bot, Cards: len(p.Hand), Books: p.Books})
+
+		if i == player {
+			v.Hand = p.Hand
+		}
+	}
+
+	return v
+}
+
+// Holds reports whether the player is known to hold a card of the value: they asked for it & have not
+// given it away or laid it down since
+func (v View) Holds(player int, value game.Value) bool {
+	for i := len(v.Log) - 1; i >= 0; i-- {
+		t := v.Log[i]
+
+		switch {
+		case t.Player == player && containsValue(t.Books, value):
+			return false
+		case t.Player == player && t.Target != NoTarget && t.Value == value:
+			return true
+		case t.Target == player && t.Value == value && t.Got != 0:
+			return false
+		}
+	}
+
+	return false
+}
+
+// Lacks reports whether the player is known to hold no card of the value: they were asked for it, or laid it
+// down, & have not drawn any cards since
+func (v View) Lacks(player int, value game.Value) bool {
+	if v.Players[player].Cards == 0 {
+		return true
+	}
+
+	for i := len(v.Log) - 1; i >= 0; i-- {
+		t := v.Log[i]
+
+		switch {
+		case t.Player == player && containsValue(t.Books, value):
+			return true
+		case t.Player == player && t.Target != NoTarget && t.Value == value:
+			return false
+		case t.Player == player && t.Drawn != 0:
+			return false
+		case t.Target == player && t.Value == value:
+			return true
+		}
+	}
+
+	return false
+}
+
+// persistedLog returns the turns that are needed to restore the game: the last turn matching any of the cases
+// of Holds or of Lacks for every player & value, so that both report the same after a restart, & the last
+// recentTurns turns to be shown to the players. A log of any length keeps at most a few hundred turns.
+func (g *Game) persistedLog() []Turn {
+	type query struct {
+		player int
+		value  game.Value
+		lacks  bool
+	}
+
+	var (
+		keep     = make([]bool, len(g.Log))
+		resolved = make(map[query]bool)
+
+		// drawn holds the players who drew a card later on, which settles Lacks for all of their values
+		drawn = make(map[int]bool)
+	)
+
+	for i := len(g.Log) - 1; i >= 0; i-- {
+		t := g.Log[i]
+
+		resolve := func(q query) {
+			if !resolved[q] && !(q.lacks && drawn[q.player]) {
+				resolved[q] = true
+				keep[i] = true
+			}
+		}
+
+		for _, value := range t.Books {
+			resolve(query{player: t.Player, value: value})
+			resolve(query{player: t.Player, value: value, lacks: true})
+		}
+
+		if t.Target != NoTarget {
+			resolve(query{player: t.Player, value: t.Value})
+			resolve(query{player: t.Player, value: t.Value, lacks: true})
+			resolve(query{player: t.Target, value: t.Value, lacks: true})
+
+			if t.Got != 0 {
+				resolve(query{player: t.Target, value: t.Value})
+			}
+		}
+
+		if t.Drawn != 0 && !drawn[t.Player] {
+			drawn[t.Player] = true
+			keep[i] = true
+		}
+
+		if i >= len(g.Log)-recentTurns {
+			keep[i] = true
+		}
+	}
+
+	log := make([]Turn, 0, len(g.Log))
+	for i, t := range g.Log {
+		if keep[i] {
+			log = append(log, t)
+		}
+	}
+
+	return log
+}
+
+// values returns the values in the hand from the most held to the least held
+func (v View) values() []game.Value {
+	var counts [game.ValuesTotalCount]int
+	for _, card := range v.Hand {
+		counts[card.Value]++
+	}
+
+	var values []game.Value
+	for value, count := range counts {
+		if count != 0 {
+			values = append(values, game.Value(value))
+		}
+	}
+
+	sort.SliceStable(values, func(i, j int) bool {
+		return counts[values[i]] > counts[values[j]]
+	})
+
+	return values
+}
+
+// opponents returns the other players from the one holding the most cards to the one holding the fewest
+func (v View) opponents() []int {
+	var opponents []int
+	for i := range v.Players {
+		if i != v.Player {
+			opponents = append(opponents, i)
+		}
+	}
+
+	sort.SliceStable(opponents, func(i, j int) bool {
+		return v.Players[opponents[i]].Cards > v.Players[opponents[j]].Cards
+	})
+
+	return opponents
+}
+
+// Basic asks for the value it holds the most of, skipping the players known to lack it
+type Basic struct{}
+
+func (Basic) Name() string {
+	return "basic"
+}
+
+func (Basic) Ask(v View) (int, game.Value) {
+	values, opponents := v.values(), v.opponents()
+
+	for _, value := range values {
+		for _, target := range opponents {
+			if !v.Lacks(target, value) {
+				return target, value
+			}
+		}
+	}
+
+	// everybody else is known to lack the values, so the cards are in the stock
+	return opponents[0], values[0]
+}
+
+// Memory remembers the values the other players asked for & asks them back, playing like Basic otherwise
+type Memory struct{}
+
+func (Memory) Name() string {
+	return "memory"
+}
+
+func (Memory) Ask(v View) (int, game.Value) {
+	for _, value := range v.values() {
+		for _, target := range v.opponents() {
+			if v.Holds(target, value) {
+				return target, value
+			}
+		}
+	}
+
+	return Basic{}.Ask(v)
+}
+
+func containsValue(values []game.Value, value game.Value) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+
+	return false
+}
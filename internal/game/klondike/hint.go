@@ -0,0 +1,132 @@
+package klondike
+
+import "github.com/AntonAverchenkov/cards-http-service/internal/game"
+
+// HintLimit caps the number of the positions searched for a hint, which keeps the search well under a second
+const HintLimit = 10000
+
+// Hint is the outcome of the search for a way to win the game
+type Hint struct {
+	// Solution wins the game from the current position (nil if none was found)
+	Solution []Move
+
+	// Explored is the number of the positions searched
+	Explored int
+}
+
+// Hint searches up to limit positions for a way to win the game; the search skips the moves that seldom help
+// (e.g. the ones off the foundations), so a game without a solution found may still be winnable
+func (g *Game) Hint(limit int) Hint {
+	s := solver{
+		visited: make(map[string]bool),
+		limit:   limit,
+	}
+
+	solution, _ := s.search(g.clone())
+
+	return Hint{
+		Solution: solution,
+		Explored: s.explored,
+	}
+}
+
+// solver is a depth-first search that never visits a position twice
+type solver struct {
+	visited  map[string]bool
+	limit    int
+	explored int
+}
+
+func (s *solver) search(g *Game) ([]Move, bool) {
+	if g.Status() == StatusWon {
+		return nil, true
+	}
+
+	key := g.key()
+	if s.visited[key] || s.explored >= s.limit {
+		return nil, false
+	}
+
+	s.visited[key] = true
+	s.explored++
+
+	for _, m := range g.promisingMoves() {
+		next := g.clone()
+		next.apply(m)
+
+		if rest, won := s.search(next); won {
+			return append([]Move{m}, rest...), true
+		}
+	}
+
+	return nil, false
+}
+
+// key encodes the position with a byte per card (& a zero byte after every pile), which is much cheaper
+// to build than the serialized game
+func (g *Game) key() string {
+	b := make([]byte, 0, int(game.SuitsTotalCount)*int(game.ValuesTotalCount)+Columns*2+4)
+
+	appendCards := func(cards []game.Card) {
+		for _, card := range cards {
+			b = append(b, byte(card.Suit)*byte(game.ValuesTotalCount)+byte(card.Value)+1)
+		}
+
+		b = append(b, 0)
+	}
+
+	appendCards(g.Stock)
+	appendCards(g.Waste)
+
+	// the foundations are told apart by their sizes alone
+	for _, foundation := range g.Foundations {
+		b = append(b, byte(len(foundation)))
+	}
+
+	for _, column := range g.Tableau {
+		b = append(b, byte(column.Hidden))
+		appendCards(column.Cards)
+	}
+
+	return string(b)
+}
+
+// promisingMoves orders the legal moves for the search: the ones to the foundations & the ones turning up
+// a face-down card go first, while the ones that cannot make progress are left out
+func (g *Game) promisingMoves() []Move {
+	var first, rest []Move
+
+	for _, m := range g.LegalMoves() {
+		switch {
+		case m.To.Kind == PileFoundation:
+			first = append(first, m)
+
+		case m.From.Kind == PileFoundation:
+			continue
+
+		case m.From.Kind == PileTableau && m.To.Kind == PileTableau:
+			column := g.Tableau[m.From.Index]
+
+			switch {
+			case m.Count == column.faceUp() && column.Hidden > 0:
+				first = append(first, m)
+			case m.Count == column.faceUp():
+				// moving a king off an otherwise empty column onto another empty one changes nothing
+				if len(g.Tableau[m.To.Index].Cards) != 0 {
+					rest = append(rest, m)
+				}
+			default:
+				// splitting a run only helps when the uncovered card goes to its foundation
+				under := column.Cards[len(column.Cards)-m.Count-1]
+				if int(under.Value) == len(g.Foundations[under.Suit]) {
+					rest = append(rest, m)
+				}
+			}
+
+		default:
+			rest = append(rest, m)
+		}
+	}
+
+	return append(first, rest...)
+}
@@ -0,0 +1,426 @@
+// Package klondike implements the klondike solitaire: the deck is dealt into 7 tableau columns & the stock,
+// and the cards are built up by suit on the foundations from the aces to the kings
+package klondike
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/AntonAverchenkov/cards-http-service/internal/game"
+)
+
+const (
+	// Columns is the number of the tableau columns
+	Columns = 7
+
+	// separator separates the piles in the serialized game
+	separator = "/"
+)
+
+// Sentinel errors that can be matched with errors.Is
+var (
+	ErrMoveIllegal = errors.New("the move is not allowed")
+	ErrGameOver    = errors.New("the game is over")
+)
+
+// Status is the state of the game
+type Status uint8
+
+// Status values
+const (
+	StatusInProgress Status = iota
+	StatusWon
+)
+
+func (s Status) String() string {
+	return [...]string{
+		"in_progress",
+		"won",
+	}[s]
+}
+
+// Column is a tableau column from the bottom to the top; the Hidden bottom cards are face down
+type Column struct {
+	Cards  []game.Card
+	Hidden int
+}
+
+// faceUp returns the number of the face-up cards, which always form a descending run of alternating colours
+func (c *Column) faceUp() int {
+	return len(c.Cards) - c.Hidden
+}
+
+// flip turns up the top card once the face-up ones are moved away
+func (c *Column) flip() {
+	if c.Hidden > 0 && c.Hidden == len(c.Cards) {
+		c.Hidden--
+	}
+}
+
+// Game is the state of a game; the stock, the waste & the foundations have their top cards last
+type Game struct {
+	// Draw is the number of the cards turned from the stock to the waste at a time (1 or 3)
+	Draw int
+
+	Stock       []game.Card
+	Waste       []game.Card
+	Foundations [game.SuitsTotalCount][]game.Card
+	Tableau     [Columns]Column
+
+	Moves int
+}
+
+// New deals the 52 cards row by row into the tableau columns of 1 to 7 cards with their top cards face up;
+// the remaining cards go to the stock in the same order, i.e. the next card of the deck is on top of it
+func New(cards []game.Card, draw int) (*Game, error) {
+	if draw != 1 && draw != 3 {
+		return nil, &game.ParseError{Input: strconv.Itoa(draw), As: "draw count"}
+	}
+
+	if size := game.CompositionStandard.Size(); len(cards) != size {
+		return nil, fmt.Errorf("%w: klondike needs all %d cards, got %d", game.ErrDeckShort, size, len(cards))
+	}
+
+	g := &Game{Draw: draw}
+
+	next := 0
+	for row := 0; row < Columns; row++ {
+		for c := row; c < Columns; c++ {
+			g.Tableau[c].Cards = append(g.Tableau[c].Cards, cards[next])
+			next++
+		}
+	}
+
+	for c := range g.Tableau {
+		g.Tableau[c].Hidden = len(g.Tableau[c].Cards) - 1
+	}
+
+	g.Stock = reversed(cards[next:])
+
+	return g, nil
+}
+
+// Status reports whether all of the cards are on the foundations
+func (g *Game) Status() Status {
+	for _, foundation := range g.Foundations {
+		if len(foundation) != int(game.ValuesTotalCount) {
+			return StatusInProgress
+		}
+	}
+
+	return StatusWon
+}
+
+// LegalMoves lists all of the moves that could be applied, the ones to the foundations first
+func (g *Game) LegalMoves() []Move {
+	if g.Status() != StatusInProgress {
+		return nil
+	}
+
+	var moves []Move
+
+	sources := []Pile{{Kind: PileWaste}}
+	for c := range g.Tableau {
+		sources = append(sources, Pile{Kind: PileTableau, Index: c})
+	}
+
+	// a card only goes onto the foundation of its suit
+	for _, from := range sources {
+		if cards := g.pile(from); g.movableCount(from) != 0 {
+			to := Pile{Kind: PileFoundation, Index: int(cards[len(cards)-1].Suit)}
+			moves = g.appendLegal(moves, Move{From: from, To: to, Count: 1})
+		}
+	}
+
+	for s := range g.Foundations {
+		sources = append(sources, Pile{Kind: PileFoundation, Index: s})
+	}
+
+	for _, from := range sources {
+		for count := 1; count <= g.movableCount(from); count++ {
+			for c := range g.Tableau {
+				moves = g.appendLegal(moves, Move{From: from, To: Pile{Kind: PileTableau, Index: c}, Count: count})
+			}
+		}
+	}
+
+	moves = g.appendLegal(moves, Move{From: Pile{Kind: PileStock}, To: Pile{Kind: PileWaste}, Count: 1})
+	moves = g.appendLegal(moves, Move{From: Pile{Kind: PileWaste}, To: Pile{Kind: PileStock}, Count: 1})
+
+	return moves
+}
+
+func (g *Game) appendLegal(moves []Move, m Move) []Move {
+	if g.validate(m) != nil {
+		return moves
+	}
+
+	return append(moves, m)
+}
+
+// Apply validates & makes the move; the top card of a tableau column is turned up once it is uncovered
+func (g *Game) Apply(m Move) error {
+	if g.Status() != StatusInProgress {
+		return ErrGameOver
+	}
+
+	if err := g.validate(m); err != nil {
+		return err
+	}
+
+	g.apply(m)
+	g.Moves++
+
+	return nil
+}
+
+func (g *Game) validate(m Move) error {
+	switch {
+	case m.From.Kind == PileStock && m.To.Kind == PileWaste:
+		if len(g.Stock) == 0 {
+			return &MoveError{Move: m, Reason: "the stock is empty"}
+		}
+
+		return nil
+
+	case m.From.Kind == PileWaste && m.To.Kind == PileStock:
+		if len(g.Stock) != 0 || len(g.Waste) == 0 {
+			return &MoveError{Move: m, Reason: "the waste is only turned over onto an empty stock"}
+		}
+
+		return nil
+	}
+
+	if m.Count < 1 || m.Count > g.movableCount(m.From) {
+		return &MoveError{Move: m, Reason: "there are not as many cards to move"}
+	}
+
+	cards := g.pile(m.From)
+	card := cards[len(cards)-m.Count]
+
+	switch m.To.Kind {
+	case PileFoundation:
+		foundation := g.Foundations[m.To.Index]
+
+		if m.Count != 1 || card.Suit != game.Suit(m.To.Index) || int(card.Value) != len(foundation) {
+			return &MoveError{Move: m, Reason: "the foundations are built up by suit one card at a time"}
+		}
+
+	case PileTableau:
+		column := g.Tableau[m.To.Index]
+
+		if m.From == m.To {
+			return &MoveError{Move: m, Reason: "the cards cannot be moved onto the same column"}
+		}
+
+		if len(column.Cards) == 0 {
+			if card.Value != game.ValueKing {
+				return &MoveError{Move: m, Reason: "only a king goes onto an empty column"}
+			}
+
+			return nil
+		}
+
+		top := column.Cards[len(column.Cards)-1]
+
+		if red(top.Suit) == red(card.Suit) || top.Value != card.Value+1 {
+			return &MoveError{Move: m, Reason: "the columns are built down in alternating colours"}
+		}
+
+	default:
+		return &MoveError{Move: m, Reason: "the cards only go onto the foundations & the columns"}
+	}
+
+	return nil
+}
+
+func (g *Game) apply(m Move) {
+	switch {
+	case m.From.Kind == PileStock:
+		for i := 0; i < g.Draw && len(g.Stock) != 0; i++ {
+			g.Waste = append(g.Waste, g.Stock[len(g.Stock)-1])
+			g.Stock = g.Stock[:len(g.Stock)-1]
+		}
+
+		return
+
+	case m.To.Kind == PileStock:
+		g.Stock, g.Waste = reversed(g.Waste), nil
+		return
+	}
+
+	from := g.pilePtr(m.From)
+	moved := append([]game.Card(nil), (*from)[len(*from)-m.Count:]...)
+	*from = (*from)[:len(*from)-m.Count]
+
+	to := g.pilePtr(m.To)
+	*to = append(*to, moved...)
+
+	if m.From.Kind == PileTableau {
+		g.Tableau[m.From.Index].flip()
+	}
+}
+
+// movableCount returns the number of the cards that could be taken off the pile
+func (g *Game) movableCount(p Pile) int {
+	switch p.Kind {
+	case PileWaste:
+		return min(1, len(g.Waste))
+	case PileFoundation:
+		return min(1, len(g.Foundations[p.Index]))
+	case PileTableau:
+		return g.Tableau[p.Index].faceUp()
+	default:
+		return 0
+	}
+}
+
+func (g *Game) pile(p Pile) []game.Card {
+	return *g.pilePtr(p)
+}
+
+func (g *Game) pilePtr(p Pile) *[]game.Card {
+	switch p.Kind {
+	case PileStock:
+		return &g.Stock
+	case PileWaste:
+		return &g.Waste
+	case PileFoundation:
+		return &g.Foundations[p.Index]
+	default:
+		return &g.Tableau[p.Index].Cards
+	}
+}
+
+// Cards returns all of the cards in the game: the foundations, the tableau columns, the waste & the stock
+func (g *Game) Cards() []game.Card {
+	var cards []game.Card
+
+	for _, foundation := range g.Foundations {
+		cards = append(cards, foundation...)
+	}
+	for _, column := range g.Tableau {
+		cards = append(cards, column.Cards...)
+	}
+
+	cards = append(cards, g.Waste...)
+
+	return append(cards, g.Stock...)
+}
+
+// clone returns a deep copy of the game
+func (g *Game) clone() *Game {
+	c := &Game{
+		Draw:  g.Draw,
+		Stock: append([]game.Card(nil), g.Stock...),
+		Waste: append([]game.Card(nil), g.Waste...),
+		Moves: g.Moves,
+	}
+
+	for s := range g.Foundations {
+		c.Foundations[s] = append([]game.Card(nil), g.Foundations[s]...)
+	}
+	for i, column := range g.Tableau {
+		c.Tableau[i] = Column{Cards: append([]game.Card(nil), column.Cards...), Hidden: column.Hidden}
+	}
+
+	return c
+}
+
+// Serialize encodes the game as 'draw/moves/stock/waste/4 foundations/7 columns' with the cards in the short
+// form from the bottom to the top & the columns prefixed by the number of their face-down cards, e.g. '2:5hqckd'
+func (g *Game) Serialize() string {
+	tokens := []string{strconv.Itoa(g.Draw), strconv.Itoa(g.Moves), serializeCards(g.Stock), serializeCards(g.Waste)}
+
+	for _, foundation := range g.Foundations {
+		tokens = append(tokens, serializeCards(foundation))
+	}
+	for _, column := range g.Tableau {
+		tokens = append(tokens, strconv.Itoa(column.Hidden)+":"+serializeCards(column.Cards))
+	}
+
+	return strings.Join(tokens, separator)
+}
+
+// Deserialize decodes a game written by Serialize
+func Deserialize(str string) (*Game, error) {
+	tokens := strings.Split(str, separator)
+	if len(tokens) != 4+int(game.SuitsTotalCount)+Columns {
+		return nil, fmt.Errorf("incorrect number of tokens in the klondike game %q", str)
+	}
+
+	draw, err := strconv.Atoi(tokens[0])
+	if err != nil || (draw != 1 && draw != 3) {
+		return nil, fmt.Errorf("could not parse the klondike game's draw count %q", tokens[0])
+	}
+
+	moves, err := strconv.Atoi(tokens[1])
+	if err != nil {
+		return nil, fmt.Errorf("could not parse the klondike game's moves: %w", err)
+	}
+
+	g := &Game{Draw: draw, Moves: moves}
+
+	piles := []*[]game.Card{&g.Stock, &g.Waste}
+	for s := range g.Foundations {
+		piles = append(piles, &g.Foundations[s])
+	}
+
+	for i, dst := range piles {
+		if *dst, err = deserializeCards(tokens[2+i]); err != nil {
+			return nil, err
+		}
+	}
+
+	for c := range g.Tableau {
+		hidden, cards, found := strings.Cut(tokens[2+len(piles)+c], ":")
+		if !found {
+			return nil, fmt.Errorf("could not parse the klondike game's column %q", tokens[2+len(piles)+c])
+		}
+
+		column := &g.Tableau[c]
+
+		if column.Cards, err = deserializeCards(cards); err != nil {
+			return nil, err
+		}
+
+		if column.Hidden, err = strconv.Atoi(hidden); err != nil || column.Hidden < 0 || column.Hidden > len(column.Cards) {
+			return nil, fmt.Errorf("could not parse the klondike game's face-down cards %q", hidden)
+		}
+	}
+
+	return g, nil
+}
+
+func serializeCards(cards []game.Card) string {
+	return (&game.Deck{Cards: cards}).Serialize()
+}
+
+func deserializeCards(str string) ([]game.Card, error) {
+	deck, err := game.DeckDeserialize(str)
+	if err != nil {
+		return nil, fmt.Errorf("could not parse the klondike game: %w", err)
+	}
+
+	if len(deck.Cards) == 0 {
+		return nil, nil
+	}
+
+	return deck.Cards, nil
+}
+
+func reversed(cards []game.Card) []game.Card {
+	result := make([]game.Card, len(cards))
+
+	for i, card := range cards {
+		result[len(cards)-1-i] = card
+	}
+
+	return result
+}
+
+func red(s game.Suit) bool {
+	return s == game.SuitHearts || s == game.SuitDiamonds
+}
@@ -0,0 +1,194 @@
+package klondike
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/AntonAverchenkov/cards-http-service/internal/game"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newGame(t *testing.T, draw int) *Game {
+	t.Helper()
+
+	g, err := New(game.NewDeck().Cards, draw)
+	require.NoError(t, err)
+
+	return g
+}
+
+func move(t *testing.T, from, to string, count int) Move {
+	t.Helper()
+
+	f, err := ParsePile(from)
+	require.NoError(t, err)
+
+	d, err := ParsePile(to)
+	require.NoError(t, err)
+
+	return Move{From: f, To: d, Count: count}
+}
+
+func moveNames(moves []Move) (names []string) {
+	for _, m := range moves {
+		names = append(names, m.String())
+	}
+
+	return names
+}
+
+// run returns the short form of the cards of the suit from the ace up to (but not including) the value
+func run(suit string, until game.Value) string {
+	var b strings.Builder
+
+	for v := game.ValueAce; v < until; v++ {
+		b.WriteString(v.ShortString() + suit)
+	}
+
+	return b.String()
+}
+
+func TestNew(t *testing.T) {
+	// the sorted deck: the ace to the seven of clubs go on top of the columns 1-7, then the eight of clubs...
+	g := newGame(t, 1)
+
+	assert.Equal(t, "ac", serializeCards(g.Tableau[0].Cards))
+	assert.Equal(t, "2c8c", serializeCards(g.Tableau[1].Cards))
+	assert.Equal(t, "7ckc5h9hqhad2d", serializeCards(g.Tableau[6].Cards))
+	assert.Equal(t, 6, g.Tableau[6].Hidden)
+
+	require.Len(t, g.Stock, 24)
+	assert.Equal(t, "3d", g.Stock[len(g.Stock)-1].ShortString())
+	assert.ElementsMatch(t, game.NewDeck().Cards, g.Cards())
+
+	_, err := New(game.NewDeck().Cards[1:], 1)
+	assert.ErrorIs(t, err, game.ErrDeckShort)
+
+	_, err = New(game.NewDeck().Cards, 2)
+	assert.ErrorIs(t, err, game.ErrUnparseable)
+}
+
+func TestLegalMoves(t *testing.T) {
+	g := newGame(t, 1)
+
+	// the tops are ac 8c ah 6h th kh 2d
+	assert.Equal(t, []string{"t1>fc", "t3>fh", "t1>t7", "stock>waste"}, moveNames(g.LegalMoves()))
+
+	require.NoError(t, g.Apply(move(t, "t1", "fc", 1)))
+	assert.Empty(t, g.Tableau[0].Cards)
+
+	// the ten of clubs is turned up under the ace of hearts
+	require.NoError(t, g.Apply(move(t, "t3", "fh", 1)))
+	assert.Equal(t, 1, g.Tableau[2].Hidden)
+	assert.Equal(t, 1, g.Tableau[2].faceUp())
+
+	// only a king goes onto the empty column
+	assert.Contains(t, moveNames(g.LegalMoves()), "t6>t1")
+	assert.ErrorIs(t, g.Apply(move(t, "t2", "t1", 1)), ErrMoveIllegal)
+
+	require.NoError(t, g.Apply(move(t, "t6", "t1", 1)))
+	assert.Equal(t, 3, g.Moves)
+}
+
+func TestTableauBuilding(t *testing.T) {
+	g, err := Deserialize("1/0///////0:kh/0:qs/0:qh/0:js/0:th9c/0:qd/0:")
+	require.NoError(t, err)
+
+	// alternating colours & descending ranks
+	assert.NoError(t, g.validate(move(t, "t2", "t1", 1)))
+	assert.ErrorIs(t, g.validate(move(t, "t3", "t1", 1)), ErrMoveIllegal)
+	assert.ErrorIs(t, g.validate(move(t, "t4", "t1", 1)), ErrMoveIllegal)
+
+	// a run of cards moves together
+	require.NoError(t, g.Apply(move(t, "t4", "t3", 1)))
+	require.NoError(t, g.Apply(move(t, "t5", "t3", 2)))
+	assert.Equal(t, "qhjsth9c", serializeCards(g.Tableau[2].Cards))
+
+	require.NoError(t, g.Apply(move(t, "t3", "t6", 3)))
+	assert.Equal(t, "qdjsth9c", serializeCards(g.Tableau[5].Cards))
+	assert.ErrorIs(t, g.validate(move(t, "t3", "t7", 1)), ErrMoveIllegal)
+	assert.ErrorIs(t, g.validate(move(t, "t6", "t3", 5)), ErrMoveIllegal)
+
+	// the cards go to the foundations one at a time & in order
+	assert.ErrorIs(t, g.validate(move(t, "t1", "fh", 1)), ErrMoveIllegal)
+	assert.ErrorIs(t, g.validate(move(t, "t6", "fc", 2)), ErrMoveIllegal)
+}
+
+func TestStockWaste(t *testing.T) {
+	g := newGame(t, 3)
+
+	require.NoError(t, g.Apply(move(t, "stock", "waste", 1)))
+	assert.Equal(t, "3d4d5d", serializeCards(g.Waste))
+	assert.Len(t, g.Stock, 21)
+
+	// only the top card of the waste could be played
+	assert.Equal(t, 1, g.movableCount(Pile{Kind: PileWaste}))
+
+	assert.ErrorIs(t, g.Apply(move(t, "waste", "stock", 1)), ErrMoveIllegal)
+
+	for len(g.Stock) != 0 {
+		require.NoError(t, g.Apply(move(t, "stock", "waste", 1)))
+	}
+
+	assert.ErrorIs(t, g.Apply(move(t, "stock", "waste", 1)), ErrMoveIllegal)
+
+	// the waste is turned over, so the stock is in its original order again
+	require.NoError(t, g.Apply(move(t, "waste", "stock", 1)))
+	assert.Empty(t, g.Waste)
+	assert.Equal(t, "3d", g.Stock[len(g.Stock)-1].ShortString())
+}
+
+func TestHint(t *testing.T) {
+	// the king of hearts is face down under the king of clubs; the queen of diamonds is in the waste
+	str := "1/0//qd/" + run("c", game.ValueKing) + "/" + run("h", game.ValueKing) + "/" + run("d", game.ValueQueen) + "/" +
+		run("s", game.ValuesTotalCount) + "/1:khkc/0:kd/0:/0:/0:/0:/0:"
+
+	g, err := Deserialize(str)
+	require.NoError(t, err)
+
+	hint := g.Hint(HintLimit)
+	assert.Equal(t, []string{"waste>fd", "t1>fc", "t1>fh", "t2>fd"}, moveNames(hint.Solution))
+
+	for _, m := range hint.Solution {
+		require.NoError(t, g.Apply(m))
+	}
+
+	assert.Equal(t, StatusWon, g.Status())
+	assert.Empty(t, g.LegalMoves())
+	assert.ErrorIs(t, g.Apply(move(t, "fc", "t1", 1)), ErrGameOver)
+
+	// a fresh deal takes a longer search
+	hint = newGame(t, 1).Hint(100)
+	assert.LessOrEqual(t, hint.Explored, 100)
+}
+
+func TestSerialize(t *testing.T) {
+	g := newGame(t, 3)
+
+	require.NoError(t, g.Apply(move(t, "t1", "fc", 1)))
+	require.NoError(t, g.Apply(move(t, "stock", "waste", 1)))
+
+	restored, err := Deserialize(g.Serialize())
+	require.NoError(t, err)
+	assert.Equal(t, g.Serialize(), restored.Serialize())
+	assert.Equal(t, g.Tableau[1], restored.Tableau[1])
+
+	for _, str := range []string{"1/0", "2/0///////0:/0:/0:/0:/0:/0:/0:", "1/0///////9:ah/0:/0:/0:/0:/0:/0:", "1/0///////ah/0:/0:/0:/0:/0:/0:"} {
+		_, err := Deserialize(str)
+		assert.Error(t, err, str)
+	}
+}
+
+func TestParsePile(t *testing.T) {
+	for _, name := range []string{"stock", "waste", "fc", "fs", "t1", "t7"} {
+		pile, err := ParsePile(name)
+		require.NoError(t, err)
+		assert.Equal(t, name, pile.String())
+	}
+
+	for _, name := range []string{"t0", "t8", "fx", "tableau"} {
+		_, err := ParsePile(name)
+		assert.ErrorIs(t, err, game.ErrUnparseable, name)
+	}
+}
@@ -0,0 +1,95 @@
+package klondike
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/AntonAverchenkov/cards-http-service/internal/game"
+)
+
+// PileKind tells the stock, the waste, the foundations & the tableau columns apart
+type PileKind uint8
+
+// PileKind values
+const (
+	PileStock PileKind = iota
+	PileWaste
+	PileFoundation
+	PileTableau
+)
+
+// Pile is one of the places the cards are kept in
+type Pile struct {
+	Kind PileKind
+
+	// Index is the suit of a foundation or the column (0 to 6) of the tableau
+	Index int
+}
+
+// ParsePile will parse the name of a pile: "stock", "waste", a foundation by its suit ("fh" for the hearts)
+// or a tableau column from "t1" to "t7"
+func ParsePile(str string) (Pile, error) {
+	name := strings.ToLower(str)
+
+	switch {
+	case name == "stock":
+		return Pile{Kind: PileStock}, nil
+	case name == "waste":
+		return Pile{Kind: PileWaste}, nil
+	case strings.HasPrefix(name, "f"):
+		if s, err := game.ParseSuit(name[1:]); err == nil {
+			return Pile{Kind: PileFoundation, Index: int(s)}, nil
+		}
+	case strings.HasPrefix(name, "t"):
+		if c, err := strconv.Atoi(name[1:]); err == nil && c >= 1 && c <= Columns {
+			return Pile{Kind: PileTableau, Index: c - 1}, nil
+		}
+	}
+
+	return Pile{}, &game.ParseError{Input: str, As: "pile"}
+}
+
+func (p Pile) String() string {
+	switch p.Kind {
+	case PileStock:
+		return "stock"
+	case PileWaste:
+		return "waste"
+	case PileFoundation:
+		return "f" + game.Suit(p.Index).ShortString()
+	default:
+		return fmt.Sprintf("t%d", p.Index+1)
+	}
+}
+
+// Move takes the Count top cards of a pile onto another one; turning the stock over to the waste
+// (or the waste back over to the stock) is a move between the two of them
+type Move struct {
+	From  Pile
+	To    Pile
+	Count int
+}
+
+func (m Move) String() string {
+	if m.Count > 1 {
+		return fmt.Sprintf("%s>%s:%d", m.From, m.To, m.Count)
+	}
+
+	return fmt.Sprintf("%s>%s", m.From, m.To)
+}
+
+// MoveError is returned when a move breaks the rules
+type MoveError struct {
+	Move   Move
+	Reason string
+}
+
+func (e *MoveError) Error() string {
+	return fmt.Sprintf("the move '%s' is not allowed: %s", e.Move, e.Reason)
+}
+
+// Is makes errors.Is(err, ErrMoveIllegal) succeed
+func (e *MoveError) Is(target error) bool {
+	return target == ErrMoveIllegal
+}
@@ -0,0 +1,111 @@
+package game
+
+import (
+	"sort"
+	"strings"
+)
+
+// RankOrder lists the card values from the lowest to the highest
+type RankOrder []Value
+
+// SuitOrder lists the suits from the lowest to the highest
+type SuitOrder []Suit
+
+// The common rank & suit orders
+var (
+	RankAceLow  = RankOrder{ValueAce, ValueTwo, ValueThree, ValueFour, ValueFive, ValueSix, ValueSeven, ValueEight, ValueNine, ValueTen, ValueJack, ValueQueen, ValueKing}
+	RankAceHigh = RankOrder{ValueTwo, ValueThree, ValueFour, ValueFive, ValueSix, ValueSeven, ValueEight, ValueNine, ValueTen, ValueJack, ValueQueen, ValueKing, ValueAce}
+
+	// SuitNewDeck is the order of the suits in a new deck
+	SuitNewDeck = SuitOrder{SuitClubs, SuitHearts, SuitDiamonds, SuitSpades}
+
+	// SuitBridge ranks the suits alphabetically, spades being the highest
+	SuitBridge = SuitOrder{SuitClubs, SuitDiamonds, SuitHearts, SuitSpades}
+)
+
+// Rank returns the position of the value in the order (or -1 if the order does not have it)
+func (o RankOrder) Rank(v Value) int {
+	for i, value := range o {
+		if value == v {
+			return i
+		}
+	}
+
+	return -1
+}
+
+// Compare returns a negative number if a ranks lower than b, a positive one if it ranks higher & 0 otherwise
+func (o RankOrder) Compare(a, b Value) int {
+	return o.Rank(a) - o.Rank(b)
+}
+
+// Rank returns the position of the suit in the order (or -1 if the order does not have it)
+func (o SuitOrder) Rank(s Suit) int {
+	for i, suit := range o {
+		if suit == s {
+			return i
+		}
+	}
+
+	return -1
+}
+
+// Compare returns a negative number if a ranks lower than b, a positive one if it ranks higher & 0 otherwise
+func (o SuitOrder) Compare(a, b Suit) int {
+	return o.Rank(a) - o.Rank(b)
+}
+
+// CardOrder sorts the cards by their suits & then by their values (or the other way around)
+type CardOrder struct {
+	Suits SuitOrder
+	Ranks RankOrder
+
+	// RanksFirst groups the cards by their values rather than by their suits
+	RanksFirst bool
+}
+
+// The named card orders
+var (
+	OrderNewDeck = CardOrder{Suits: SuitNewDeck, Ranks: RankAceLow}
+	OrderAceHigh = CardOrder{Suits: SuitNewDeck, Ranks: RankAceHigh}
+	OrderBridge  = CardOrder{Suits: SuitBridge, Ranks: RankAceHigh}
+	OrderByRank  = CardOrder{Suits: SuitNewDeck, Ranks: RankAceLow, RanksFirst: true}
+)
+
+// ParseCardOrder will parse the name of the card order (e.g. "bridge")
+func ParseCardOrder(str string) (CardOrder, error) {
+	switch strings.ToLower(str) {
+	case "new-deck":
+		return OrderNewDeck, nil
+	case "ace-high":
+		return OrderAceHigh, nil
+	case "bridge":
+		return OrderBridge, nil
+	case "by-rank":
+		return OrderByRank, nil
+	default:
+		return CardOrder{}, &ParseError{Input: str, As: "card order"}
+	}
+}
+
+// Compare returns a negative number if a goes before b, a positive one if it goes after it & 0 otherwise
+func (o CardOrder) Compare(a, b Card) int {
+	bySuit, byRank := o.Suits.Compare(a.Suit, b.Suit), o.Ranks.Compare(a.Value, b.Value)
+
+	if o.RanksFirst {
+		bySuit, byRank = byRank, bySuit
+	}
+
+	if bySuit != 0 {
+		return bySuit
+	}
+
+	return byRank
+}
+
+// Sort orders the cards from the lowest (on top) to the highest (at the bottom)
+func (d *Deck) Sort(order CardOrder) {
+	sort.SliceStable(d.Cards, func(i, j int) bool {
+		return order.Compare(d.Cards[i], d.Cards[j]) < 0
+	})
+}
@@ -0,0 +1,43 @@
+package game
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRankOrder(t *testing.T) {
+	assert.Negative(t, RankAceLow.Compare(ValueAce, ValueTwo))
+	assert.Positive(t, RankAceHigh.Compare(ValueAce, ValueKing))
+	assert.Zero(t, RankAceHigh.Compare(ValueTen, ValueTen))
+
+	assert.Negative(t, SuitBridge.Compare(SuitDiamonds, SuitHearts))
+	assert.Positive(t, SuitNewDeck.Compare(SuitDiamonds, SuitHearts))
+}
+
+func TestDeckSort(t *testing.T) {
+	deck := NewDeck()
+	deck.Shuffle()
+
+	// the new deck order is restored
+	deck.Sort(OrderNewDeck)
+	assert.Equal(t, NewDeck().Cards, deck.Cards)
+
+	deck.Sort(OrderBridge)
+	assert.Equal(t, "2c3c4c5c6c7c8c9ctcjcqckcac2d", deck.Serialize()[:28])
+	assert.Equal(t, "qsksas", deck.Serialize()[98:])
+
+	deck.Sort(OrderByRank)
+	assert.Equal(t, "acahadas2c2h2d2s", deck.Serialize()[:16])
+}
+
+func TestParseCardOrder(t *testing.T) {
+	order, err := ParseCardOrder("Bridge")
+	require.NoError(t, err)
+	assert.Equal(t, OrderBridge, order)
+
+	_, err = ParseCardOrder("alphabetical")
+	assert.ErrorIs(t, err, ErrUnparseable)
+	assert.NotErrorIs(t, err, ErrCardUnparseable)
+}
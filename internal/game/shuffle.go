@@ -0,0 +1,133 @@
+package game
+
+import (
+	"strings"
+)
+
+// ShuffleMethod selects how the deck is permuted
+type ShuffleMethod uint8
+
+// ShuffleMethod values
+const (
+	ShuffleFisherYates       ShuffleMethod = iota // a perfectly random permutation
+	ShuffleRiffle                                 // the Gilbert-Shannon-Reeds model of a riffle shuffle
+	ShuffleOverhand                               // small packets are moved from the top one after another
+	ShuffleStrip                                  // like the overhand shuffle, but with a few large packets
+	ShuffleFaroOut                                // a perfect riffle keeping the top card on top
+	ShuffleFaroIn                                 // a perfect riffle moving the top card to the second place
+	ShuffleMethodsTotalCount                      // a marker for the end of this enum
+)
+
+const (
+	// overhandCutProbability is the chance of a packet ending after any given card (~4 cards per packet)
+	overhandCutProbability = 0.25
+
+	// stripCutProbability is the chance of a strip ending after any given card (~10 cards per strip)
+	stripCutProbability = 0.1
+)
+
+// ParseShuffleMethod will parse the method's name (e.g. "riffle")
+func ParseShuffleMethod(str string) (ShuffleMethod, error) {
+	for m := ShuffleFisherYates; m < ShuffleMethodsTotalCount; m++ {
+		if strings.ToLower(str) == m.String() {
+			return m, nil
+		}
+	}
+
+	return 0, &ParseError{Input: str, As: "shuffle method"}
+}
+
+func (m ShuffleMethod) String() string {
+	return [...]string{
+		"fisher-yates",
+		"riffle",
+		"overhand",
+		"strip",
+		"faro-out",
+		"faro-in",
+	}[m]
+}
+
+// ShuffleWith permutes the deck the given number of times using the given method
+func (d *Deck) ShuffleWith(method ShuffleMethod, times int) {
+	for i := 0; i < times; i++ {
+		switch method {
+		case ShuffleFisherYates:
+			d.Shuffle()
+		case ShuffleRiffle:
+			d.riffle()
+		case ShuffleOverhand:
+			d.reversePackets(overhandCutProbability)
+		case ShuffleStrip:
+			d.reversePackets(stripCutProbability)
+		case ShuffleFaroOut:
+			d.faro(true)
+		case ShuffleFaroIn:
+			d.faro(false)
+		}
+	}
+}
+
+// riffle cuts the deck binomially & drops the cards from either packet with
+// the probability proportional to the packet's size (Gilbert-Shannon-Reeds)
+func (d *Deck) riffle() {
+	cut := 0
+	for range d.Cards {
+		cut += d.rng.Intn(2)
+	}
+
+	left, right := d.Cards[:cut], d.Cards[cut:]
+	merged := make([]Card, 0, len(d.Cards))
+
+	for len(left) != 0 || len(right) != 0 {
+		if d.rng.Intn(len(left)+len(right)) < len(left) {
+			merged, left = append(merged, left[0]), left[1:]
+		} else {
+			merged, right = append(merged, right[0]), right[1:]
+		}
+	}
+
+	d.Cards = merged
+}
+
+// faro splits the deck exactly in half & interleaves the halves perfectly; the out-faro
+// starts with the top half (which holds the extra card of an odd deck), the in-faro with the bottom one
+func (d *Deck) faro(out bool) {
+	half := len(d.Cards) / 2
+	if out {
+		half = (len(d.Cards) + 1) / 2
+	}
+
+	first, second := d.Cards[:half], d.Cards[half:]
+	if !out {
+		first, second = second, first
+	}
+
+	merged := make([]Card, 0, len(d.Cards))
+
+	for i := range first {
+		merged = append(merged, first[i])
+
+		if i < len(second) {
+			merged = append(merged, second[i])
+		}
+	}
+
+	d.Cards = merged
+}
+
+// reversePackets splits the deck into packets, ending each after any card with the given probability,
+// & stacks them in the reverse order; the cards keep their order within the packets
+func (d *Deck) reversePackets(cutProbability float64) {
+	reversed := make([]Card, 0, len(d.Cards))
+
+	end := len(d.Cards)
+	for start := len(d.Cards) - 1; start >= 0; start-- {
+		if start == 0 || d.rng.Float64() < cutProbability {
+			reversed = append(reversed, d.Cards[start:end]...)
+			end = start
+		}
+	}
+
+	d.Cards = reversed
+}
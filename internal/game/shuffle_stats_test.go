@@ -0,0 +1,273 @@
+package game
+
+import (
+	"flag"
+	"fmt"
+	"math"
+	"math/rand"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+//
+// The statistical tests below run millions of shuffles & are skipped unless asked for:
+//
+// go test ./internal/game -run Statistical -long -v
+//
+
+var long = flag.Bool("long", false, "run the long statistical shuffle tests")
+
+// longTestsEnv enables the long tests as well, e.g. for 'go test ./...', which fails in the packages that do not
+// define the -long flag
+const longTestsEnv = "CARDS_LONG_TESTS"
+
+const (
+	// statisticalShuffles is the number of shuffles per method & source
+	statisticalShuffles = 1000000
+
+	// statisticalZ is the standard normal quantile of the significance level (~1e-5), which is
+	// strict enough for the unbiased shuffles not to fail by chance across all of the tests
+	statisticalZ = 4.265
+)
+
+// statisticalSources are the random number generators the deck may be seeded with
+var statisticalSources = []struct {
+	name string
+	rng  func() *rand.Rand
+}{{
+	name: "time-seeded",
+	rng:  func() *rand.Rand { return rand.New(rand.NewSource(time.Now().UnixNano())) },
+}, {
+	name: "fixed-seed",
+	rng:  func() *rand.Rand { return rand.New(rand.NewSource(1)) },
+}}
+
+// statisticalMethods are the shuffles under test; only the unbiased ones are expected to pass,
+// while the harness is expected to detect the bias of the others
+var statisticalMethods = []struct {
+	method   ShuffleMethod
+	times    int
+	unbiased bool
+}{
+	{method: ShuffleFisherYates, times: 1, unbiased: true},
+	{method: ShuffleRiffle, times: 7},
+	{method: ShuffleOverhand, times: 10},
+	{method: ShuffleStrip, times: 10},
+	{method: ShuffleFaroOut, times: 1},
+	{method: ShuffleFaroIn, times: 1},
+}
+
+func TestStatisticalShuffle(t *testing.T) {
+	if !*long && os.Getenv(longTestsEnv) != "1" {
+		t.Skip("skipping the statistical shuffle tests without -long or " + longTestsEnv + "=1")
+	}
+
+	for _, m := range statisticalMethods {
+		for _, source := range statisticalSources {
+			m, source := m, source
+
+			t.Run(fmt.Sprintf("%s×%d/%s", m.method, m.times, source.name), func(t *testing.T) {
+				t.Parallel()
+
+				stats := collectShuffleStats(m.method, m.times, source.rng(), statisticalShuffles)
+
+				results := map[string]bool{
+					"position-by-card": stats.positionsUniform(),
+					"adjacent pairs":   stats.pairsUniform(),
+					"rising sequences": stats.risingSequencesUniform(),
+				}
+
+				if m.unbiased {
+					for name, uniform := range results {
+						assert.True(t, uniform, "the %s are biased", name)
+					}
+					return
+				}
+
+				detected := false
+				for _, uniform := range results {
+					detected = detected || !uniform
+				}
+
+				assert.True(t, detected, "none of the tests detected the bias")
+			})
+		}
+	}
+}
+
+// shuffleStats accumulates the statistics of the shuffles of a sorted standard deck
+type shuffleStats struct {
+	shuffles int
+
+	// positions counts how many times the card (by its index in the sorted deck) lands in each position
+	positions [][]int
+
+	// pairs counts how many times a card is immediately followed by another one
+	pairs [][]int
+
+	// risingSequences counts the shuffles by their number of rising sequences
+	risingSequences []int
+}
+
+func collectShuffleStats(method ShuffleMethod, times int, rng *rand.Rand, shuffles int) *shuffleStats {
+	sorted := NewDeck().Cards
+	n := len(sorted)
+
+	index := make(map[Card]int, n)
+	for i, card := range sorted {
+		index[card] = i
+	}
+
+	stats := &shuffleStats{
+		shuffles:        shuffles,
+		positions:       newCounts(n),
+		pairs:           newCounts(n),
+		risingSequences: make([]int, n+1),
+	}
+
+	deck := NewDeck()
+	deck.rng = rng
+
+	// the indices of the shuffled cards & the positions of the indices
+	shuffled := make([]int, n)
+	position := make([]int, n)
+
+	for s := 0; s < shuffles; s++ {
+		deck.Cards = append(deck.Cards[:0], sorted...)
+		deck.ShuffleWith(method, times)
+
+		for p, card := range deck.Cards {
+			shuffled[p] = index[card]
+			position[index[card]] = p
+		}
+
+		for p, i := range shuffled {
+			stats.positions[i][p]++
+
+			if p > 0 {
+				stats.pairs[shuffled[p-1]][i]++
+			}
+		}
+
+		rising := 1
+		for i := 1; i < n; i++ {
+			if position[i] < position[i-1] {
+				rising++
+			}
+		}
+
+		stats.risingSequences[rising]++
+	}
+
+	return stats
+}
+
+// positionsUniform checks that every card is as likely to land in any of the positions
+func (s *shuffleStats) positionsUniform() bool {
+	n := len(s.positions)
+	expected := float64(s.shuffles) / float64(n)
+
+	chi := 0.0
+	for i := range s.positions {
+		for p := range s.positions[i] {
+			chi += square(float64(s.positions[i][p])-expected) / expected
+		}
+	}
+
+	return chi < chiSquareCritical((n-1)*(n-1))
+}
+
+// pairsUniform checks that every card is as likely to be followed by any of the other cards
+func (s *shuffleStats) pairsUniform() bool {
+	n := len(s.pairs)
+
+	// any of the n-1 adjacent positions holds the given ordered pair with the probability of 1/(n(n-1))
+	expected := float64(s.shuffles) / float64(n)
+
+	chi := 0.0
+	for i := range s.pairs {
+		for j := range s.pairs[i] {
+			if i != j {
+				chi += square(float64(s.pairs[i][j])-expected) / expected
+			}
+		}
+	}
+
+	return chi < chiSquareCritical(n*(n-1)-1)
+}
+
+// risingSequencesUniform checks the number of rising sequences against the eulerian
+// distribution, which a uniformly random permutation follows
+func (s *shuffleStats) risingSequencesUniform() bool {
+	n := len(s.risingSequences) - 1
+	probabilities := eulerianDistribution(n)
+
+	// the sparse tails are merged into their neighbours, so that every bin expects at least 5 shuffles
+	var (
+		chi                float64
+		bins               int
+		observed, expected float64
+		remainingExpected  = float64(s.shuffles)
+		remainingObserved  = float64(s.shuffles)
+	)
+
+	for k := 1; k <= n; k++ {
+		observed += float64(s.risingSequences[k])
+		expected += probabilities[k] * float64(s.shuffles)
+
+		if expected >= 5 && remainingExpected-expected >= 5 {
+			chi += square(observed-expected) / expected
+			bins++
+
+			remainingExpected -= expected
+			remainingObserved -= observed
+			observed, expected = 0, 0
+		}
+	}
+
+	chi += square(remainingObserved-remainingExpected) / remainingExpected
+	bins++
+
+	return chi < chiSquareCritical(bins-1)
+}
+
+// eulerianDistribution returns the probabilities of a uniformly random permutation of n cards having k rising sequences
+func eulerianDistribution(n int) []float64 {
+	// p[k] is the eulerian number A(m, k-1) divided by m!, built up from m = 1
+	p := make([]float64, n+2)
+	p[1] = 1
+
+	for m := 2; m <= n; m++ {
+		next := make([]float64, n+2)
+
+		for k := 1; k <= m; k++ {
+			next[k] = (float64(k)*p[k] + float64(m-k+1)*p[k-1]) / float64(m)
+		}
+
+		p = next
+	}
+
+	return p[:n+1]
+}
+
+// chiSquareCritical approximates the critical value of the chi-square distribution (Wilson-Hilferty)
+func chiSquareCritical(df int) float64 {
+	d := float64(df)
+	return d * math.Pow(1-2/(9*d)+statisticalZ*math.Sqrt(2/(9*d)), 3)
+}
+
+func newCounts(n int) [][]int {
+	counts := make([][]int, n)
+	for i := range counts {
+		counts[i] = make([]int, n)
+	}
+
+	return counts
+}
+
+func square(x float64) float64 {
+	return x * x
+}
@@ -0,0 +1,111 @@
+package game
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseShuffleMethod(t *testing.T) {
+	for m := ShuffleFisherYates; m < ShuffleMethodsTotalCount; m++ {
+		parsed, err := ParseShuffleMethod(m.String())
+		require.NoError(t, err)
+		assert.Equal(t, m, parsed)
+	}
+
+	_, err := ParseShuffleMethod("hindu")
+	assert.ErrorIs(t, err, ErrUnparseable)
+}
+
+func TestShuffleKeepsCards(t *testing.T) {
+	for m := ShuffleFisherYates; m < ShuffleMethodsTotalCount; m++ {
+		deck := NewDeck()
+		deck.ShuffleWith(m, 3)
+
+		assert.ElementsMatch(t, NewDeck().Cards, deck.Cards, m.String())
+	}
+}
+
+func TestFaroOutRestoresDeck(t *testing.T) {
+	deck := NewDeck()
+
+	deck.ShuffleWith(ShuffleFaroOut, 1)
+	assert.Equal(t, "acad2c2d3c3d", deck.Serialize()[:12])
+
+	// the top & the bottom cards stay in place
+	assert.Equal(t, NewDeck().Cards[0], deck.Cards[0])
+	assert.Equal(t, NewDeck().Cards[51], deck.Cards[51])
+
+	deck.ShuffleWith(ShuffleFaroOut, 7)
+	assert.Equal(t, NewDeck().Cards, deck.Cards)
+}
+
+func TestFaroInRestoresDeck(t *testing.T) {
+	deck := NewDeck()
+
+	// the top card goes to the second place
+	deck.ShuffleWith(ShuffleFaroIn, 1)
+	assert.Equal(t, NewDeck().Cards[0], deck.Cards[1])
+
+	// 2 has the order of 52 modulo 53
+	deck.ShuffleWith(ShuffleFaroIn, 51)
+	assert.Equal(t, NewDeck().Cards, deck.Cards)
+}
+
+func TestFaroOddDeck(t *testing.T) {
+	deck, err := DeckDeserialize("ac2c3c4c5c")
+	require.NoError(t, err)
+
+	deck.ShuffleWith(ShuffleFaroOut, 1)
+	assert.Equal(t, "ac4c2c5c3c", deck.Serialize())
+
+	deck, err = DeckDeserialize("ac2c3c4c5c")
+	require.NoError(t, err)
+
+	deck.ShuffleWith(ShuffleFaroIn, 1)
+	assert.Equal(t, "3cac4c2c5c", deck.Serialize())
+}
+
+// risingSequences counts the maximal runs of the consecutive cards (of the sorted deck) that keep their relative order
+func risingSequences(cards []Card) int {
+	position := make(map[Card]int, len(cards))
+	for i, card := range cards {
+		position[card] = i
+	}
+
+	sorted := NewDeck().Cards
+	count := 1
+
+	for i := 1; i < len(sorted); i++ {
+		if position[sorted[i]] < position[sorted[i-1]] {
+			count++
+		}
+	}
+
+	return count
+}
+
+func TestRiffleInterleavesTwoPackets(t *testing.T) {
+	for i := 0; i < 100; i++ {
+		deck := NewDeck()
+		deck.ShuffleWith(ShuffleRiffle, 1)
+
+		// a riffle of a sorted deck has at most 2 rising sequences
+		assert.LessOrEqual(t, risingSequences(deck.Cards), 2)
+	}
+}
+
+func TestOverhandReversesPackets(t *testing.T) {
+	original := NewDeck().Cards
+
+	for _, m := range []ShuffleMethod{ShuffleOverhand, ShuffleStrip} {
+		deck := NewDeck()
+		deck.ShuffleWith(m, 1)
+
+		// the packets are stacked in the reverse order, so the original top packet ends up at the bottom
+		k := deck.find(original[0])
+		require.NotEqual(t, -1, k)
+		assert.Equal(t, original[:len(original)-k], deck.Cards[k:], m.String())
+	}
+}
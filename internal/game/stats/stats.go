@@ -0,0 +1,166 @@
+// Package stats answers the questions about the cards remaining in a deck, e.g. how many aces are left
+// or what the chance of drawing at least 2 hearts in the next 5 cards is
+package stats
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/AntonAverchenkov/cards-http-service/internal/game"
+)
+
+// Summary counts the remaining cards
+type Summary struct {
+	Remaining int
+	Suits     [game.SuitsTotalCount]int
+	Values    [game.ValuesTotalCount]int
+
+	// BlackjackLow & BlackjackHigh sum the blackjack points of the cards with the aces counted as 1 or 11
+	BlackjackLow  int
+	BlackjackHigh int
+}
+
+// Summarize counts the cards by their suits & values
+func Summarize(cards []game.Card) Summary {
+	s := Summary{
+		Remaining: len(cards),
+	}
+
+	for _, card := range cards {
+		s.Suits[card.Suit]++
+		s.Values[card.Value]++
+
+		s.BlackjackLow += BlackjackPoints(card.Value)
+		s.BlackjackHigh += BlackjackPoints(card.Value)
+
+		if card.Value == game.ValueAce {
+			s.BlackjackHigh += 10
+		}
+	}
+
+	return s
+}
+
+// BlackjackPoints returns the points of the value in blackjack, counting the ace as 1
+func BlackjackPoints(v game.Value) int {
+	if v >= game.ValueTen {
+		return 10
+	}
+
+	return int(v) + 1
+}
+
+// Event is a kind of card to draw, e.g. a heart, an ace or a face card
+type Event struct {
+	name  string
+	match func(game.Card) bool
+}
+
+// ParseEvent will parse a category ("red", "black" or "face"), a suit ("hearts"), a value ("ace") or a card ("as")
+func ParseEvent(str string) (Event, error) {
+	name := strings.ToLower(strings.TrimSpace(str))
+
+	switch name {
+	case "red":
+		return Event{name: name, match: func(c game.Card) bool { return c.Suit == game.SuitHearts || c.Suit == game.SuitDiamonds }}, nil
+	case "black":
+		return Event{name: name, match: func(c game.Card) bool { return c.Suit == game.SuitClubs || c.Suit == game.SuitSpades }}, nil
+	case "face":
+		return Event{name: name, match: func(c game.Card) bool { return c.Value >= game.ValueJack }}, nil
+	}
+
+	if v, err := game.ParseValue(name); err == nil {
+		return Event{name: v.String(), match: func(c game.Card) bool { return c.Value == v }}, nil
+	}
+
+	if s, err := game.ParseSuit(name); err == nil {
+		return Event{name: s.String(), match: func(c game.Card) bool { return c.Suit == s }}, nil
+	}
+
+	if card, err := game.ParseCard(name); err == nil {
+		return Event{name: card.String(), match: func(c game.Card) bool { return c == card }}, nil
+	}
+
+	return Event{}, &game.ParseError{Input: str, As: "event"}
+}
+
+func (e Event) String() string {
+	return e.name
+}
+
+// Count returns the number of the cards matching the event
+func (e Event) Count(cards []game.Card) int {
+	count := 0
+
+	for _, card := range cards {
+		if e.match(card) {
+			count++
+		}
+	}
+
+	return count
+}
+
+// Odds is the answer to "what is the chance of at least AtLeast cards matching the Event in the next Draws cards"
+type Odds struct {
+	Event     Event
+	Draws     int
+	AtLeast   int
+	Matching  int // the number of the remaining cards matching the event
+	Remaining int
+
+	Probability float64
+}
+
+// AtLeast computes the chance of drawing at least k cards matching the event in the next n cards
+func AtLeast(cards []game.Card, event Event, k, n int) (Odds, error) {
+	if n > len(cards) {
+		return Odds{}, fmt.Errorf("%w: %d cards are drawn out of %d", game.ErrDeckShort, n, len(cards))
+	}
+
+	matching := event.Count(cards)
+
+	return Odds{
+		Event:       event,
+		Draws:       n,
+		AtLeast:     k,
+		Matching:    matching,
+		Remaining:   len(cards),
+		Probability: Hypergeometric(len(cards), matching, n, k),
+	}, nil
+}
+
+// Hypergeometric returns the probability of drawing at least k successes in n draws without replacement
+// from a population of the given size with the given number of successes
+func Hypergeometric(population, successes, n, k int) float64 {
+	if k <= 0 {
+		return 1
+	}
+
+	total := binomial(population, n)
+	p := 0.0
+
+	for i := k; i <= n && i <= successes; i++ {
+		p += binomial(successes, i) * binomial(population-successes, n-i) / total
+	}
+
+	return p
+}
+
+// binomial returns the number of ways to choose k out of n (which is exact in float64 for a deck of cards)
+func binomial(n, k int) float64 {
+	if k < 0 || k > n {
+		return 0
+	}
+
+	if k > n-k {
+		k = n - k
+	}
+
+	result := 1.0
+	for i := 1; i <= k; i++ {
+		result = result * float64(n-k+i) / float64(i)
+	}
+
+	return result
+}
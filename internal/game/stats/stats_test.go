@@ -0,0 +1,99 @@
+package stats
+
+import (
+	"testing"
+
+	"github.com/AntonAverchenkov/cards-http-service/internal/game"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSummarize(t *testing.T) {
+	s := Summarize(game.NewDeck().Cards)
+
+	assert.Equal(t, 52, s.Remaining)
+	assert.Equal(t, 13, s.Suits[game.SuitHearts])
+	assert.Equal(t, 4, s.Values[game.ValueAce])
+	assert.Equal(t, 340, s.BlackjackLow)
+	assert.Equal(t, 380, s.BlackjackHigh)
+
+	deck, err := game.DeckDeserialize("ahqs3d")
+	require.NoError(t, err)
+
+	s = Summarize(deck.Cards)
+	assert.Equal(t, 3, s.Remaining)
+	assert.Equal(t, 1, s.Suits[game.SuitSpades])
+	assert.Equal(t, 0, s.Suits[game.SuitClubs])
+	assert.Equal(t, 14, s.BlackjackLow)
+	assert.Equal(t, 24, s.BlackjackHigh)
+}
+
+func TestParseEvent(t *testing.T) {
+	cards := game.NewDeck().Cards
+
+	successCases := []struct {
+		str   string
+		name  string
+		count int
+	}{
+		{str: "red", name: "red", count: 26},
+		{str: "Face", name: "face", count: 12},
+		{str: "hearts", name: "hearts", count: 13},
+		{str: "♠", name: "spades", count: 13},
+		{str: "a", name: "ace", count: 4},
+		{str: "10", name: "ten", count: 4},
+		{str: "as", name: "ace of spades", count: 1},
+		{str: "queen of hearts", name: "queen of hearts", count: 1},
+	}
+
+	for _, c := range successCases {
+		event, err := ParseEvent(c.str)
+		require.NoError(t, err, c.str)
+		assert.Equal(t, c.name, event.String())
+		assert.Equal(t, c.count, event.Count(cards), c.str)
+	}
+
+	_, err := ParseEvent("joker")
+	assert.ErrorIs(t, err, game.ErrUnparseable)
+}
+
+func TestAtLeast(t *testing.T) {
+	cards := game.NewDeck().Cards
+
+	ace, err := ParseEvent("ace")
+	require.NoError(t, err)
+
+	// the next card
+	odds, err := AtLeast(cards, ace, 1, 1)
+	require.NoError(t, err)
+	assert.InDelta(t, 4.0/52, odds.Probability, 1e-12)
+	assert.Equal(t, 4, odds.Matching)
+
+	// 1 - C(48,5)/C(52,5)
+	odds, err = AtLeast(cards, ace, 1, 5)
+	require.NoError(t, err)
+	assert.InDelta(t, 1-1712304.0/2598960, odds.Probability, 1e-12)
+
+	// all 4 aces in the whole deck
+	odds, err = AtLeast(cards, ace, 4, 52)
+	require.NoError(t, err)
+	assert.InDelta(t, 1, odds.Probability, 1e-12)
+
+	// more than there are
+	odds, err = AtLeast(cards, ace, 5, 52)
+	require.NoError(t, err)
+	assert.Zero(t, odds.Probability)
+
+	_, err = AtLeast(cards[:3], ace, 1, 4)
+	assert.ErrorIs(t, err, game.ErrDeckShort)
+}
+
+func TestHypergeometricSumsToOne(t *testing.T) {
+	// P(X >= k) - P(X >= k+1) is P(X = k), which sums up to 1
+	total := 0.0
+	for k := 0; k <= 13; k++ {
+		total += Hypergeometric(52, 13, 13, k) - Hypergeometric(52, 13, 13, k+1)
+	}
+
+	assert.InDelta(t, 1, total, 1e-12)
+}
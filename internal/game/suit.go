@@ -1,7 +1,6 @@
 package game
 
 import (
-	"fmt"
 	"strings"
 )
 
@@ -17,20 +16,20 @@ const (
 	SuitsTotalCount // the total number of suits
 )
 
-// ParseSuit will parse a suit string in short (c) or long (clubs) forms
+// ParseSuit will parse a suit string in short (c), long (clubs) or symbol (♣) forms
 func ParseSuit(str string) (Suit, error) {
 	switch strings.ToLower(str) {
 
-	case "c", "clubs":
+	case "c", "clubs", "♣", "♧":
 		return SuitClubs, nil
-	case "h", "hearts":
+	case "h", "hearts", "♥", "♡":
 		return SuitHearts, nil
-	case "d", "diamonds":
+	case "d", "diamonds", "♦", "♢":
 		return SuitDiamonds, nil
-	case "s", "spades":
+	case "s", "spades", "♠", "♤":
 		return SuitSpades, nil
 	default:
-		return 0, fmt.Errorf("could not parse '%s' as suit", str)
+		return 0, &ParseError{Input: str, As: "suit"}
 	}
 }
 
@@ -0,0 +1,94 @@
+package game
+
+import (
+	"unicode/utf8"
+)
+
+// The playing cards unicode block starts at U+1F0A0 with one row of 16 code points per suit
+const (
+	glyphBlockStart rune = 0x1F0A0
+	glyphBlockEnd   rune = 0x1F0FF
+)
+
+// glyphSuitRows maps the suits onto their rows in the playing cards unicode block
+var glyphSuitRows = [...]rune{
+	SuitSpades:   0x1F0A0,
+	SuitHearts:   0x1F0B0,
+	SuitDiamonds: 0x1F0C0,
+	SuitClubs:    0x1F0D0,
+}
+
+// glyphValueColumns maps the values onto their columns in the playing cards unicode block
+// (the column 0xC is reserved for the knight, which is not a part of the standard deck)
+var glyphValueColumns = [...]rune{
+	ValueAce:   0x1,
+	ValueTwo:   0x2,
+	ValueThree: 0x3,
+	ValueFour:  0x4,
+	ValueFive:  0x5,
+	ValueSix:   0x6,
+	ValueSeven: 0x7,
+	ValueEight: 0x8,
+	ValueNine:  0x9,
+	ValueTen:   0xA,
+	ValueJack:  0xB,
+	ValueQueen: 0xD,
+	ValueKing:  0xE,
+}
+
+// Symbol returns the unicode suit symbol ("♠")
+func (s Suit) Symbol() string {
+	return [...]string{
+		"♣",
+		"♥",
+		"♦",
+		"♠",
+	}[s]
+}
+
+// Symbol returns the index printed in the card's corner ("A", "10", "K")
+func (v Value) Symbol() string {
+	return [...]string{
+		"A",
+		"2",
+		"3",
+		"4",
+		"5",
+		"6",
+		"7",
+		"8",
+		"9",
+		"10",
+		"J",
+		"Q",
+		"K",
+	}[v]
+}
+
+// Symbol will return something like "A♥"
+func (c Card) Symbol() string {
+	return c.Value.Symbol() + c.Suit.Symbol()
+}
+
+// Glyph will return the unicode playing card glyph, something like "🂱"
+func (c Card) Glyph() string {
+	return string(glyphSuitRows[c.Suit] + glyphValueColumns[c.Value])
+}
+
+// parseGlyph will parse a single unicode playing card glyph
+func parseGlyph(str string) (Card, bool) {
+	r, size := utf8.DecodeRuneInString(str)
+	if size != len(str) || r < glyphBlockStart || r > glyphBlockEnd {
+		return Card{}, false
+	}
+
+	for s, row := range glyphSuitRows {
+		for v, column := range glyphValueColumns {
+			if row+column == r {
+				return Card{Value: Value(v), Suit: Suit(s)}, true
+			}
+		}
+	}
+
+	return Card{}, false
+}
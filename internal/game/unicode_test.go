@@ -0,0 +1,35 @@
+package game
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCardSymbol(t *testing.T) {
+	assert.Equal(t, "A♠", Card{Value: ValueAce, Suit: SuitSpades}.Symbol())
+	assert.Equal(t, "3♣", Card{Value: ValueThree, Suit: SuitClubs}.Symbol())
+	assert.Equal(t, "10♦", Card{Value: ValueTen, Suit: SuitDiamonds}.Symbol())
+	assert.Equal(t, "K♥", Card{Value: ValueKing, Suit: SuitHearts}.Symbol())
+}
+
+func TestCardGlyph(t *testing.T) {
+	assert.Equal(t, "🂡", Card{Value: ValueAce, Suit: SuitSpades}.Glyph())
+	assert.Equal(t, "🃓", Card{Value: ValueThree, Suit: SuitClubs}.Glyph())
+	assert.Equal(t, "🃋", Card{Value: ValueJack, Suit: SuitDiamonds}.Glyph())
+	assert.Equal(t, "🂽", Card{Value: ValueQueen, Suit: SuitHearts}.Glyph())
+	assert.Equal(t, "🂾", Card{Value: ValueKing, Suit: SuitHearts}.Glyph())
+}
+
+func TestUnicodeRoundTrip(t *testing.T) {
+	for _, card := range NewDeck().Cards {
+		c, err := ParseCard(card.Symbol())
+		require.NoError(t, err)
+		assert.Equal(t, card, c)
+
+		c, err = ParseCard(card.Glyph())
+		require.NoError(t, err)
+		assert.Equal(t, card, c)
+	}
+}
@@ -1,7 +1,6 @@
 package game
 
 import (
-	"fmt"
 	"strings"
 )
 
@@ -47,7 +46,7 @@ func ParseValue(str string) (Value, error) {
 		return ValueEight, nil
 	case "9", "nine":
 		return ValueNine, nil
-	case "t", "ten":
+	case "t", "ten", "10":
 		return ValueTen, nil
 	case "j", "jack":
 		return ValueJack, nil
@@ -56,7 +55,7 @@ func ParseValue(str string) (Value, error) {
 	case "k", "king":
 		return ValueKing, nil
 	default:
-		return 0, fmt.Errorf("could not parse '%s' as card value", str)
+		return 0, &ParseError{Input: str, As: "card value"}
 	}
 }
 
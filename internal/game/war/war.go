@@ -0,0 +1,239 @@
+// Package war implements the two-player game of war: the deck is dealt into two piles, the players
+// turn their top cards up & the higher one (aces high) takes both; the ties are broken by a war
+package war
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/AntonAverchenkov/cards-http-service/internal/game"
+)
+
+const (
+	// MaxRounds ends the game in a draw, since a game of war may go on forever
+	MaxRounds = 10000
+
+	// faceDown is the number of the cards each player puts face down in a war
+	faceDown = 3
+
+	// separator separates the piles, the table & the rounds in the serialized game
+	separator = "/"
+)
+
+// ErrGameOver is returned when a round is played after the game is over
+var ErrGameOver = errors.New("the game is over")
+
+// Status is the state of the game
+type Status uint8
+
+// Status values
+const (
+	StatusInProgress Status = iota
+	StatusWon
+	StatusDraw
+)
+
+func (s Status) String() string {
+	return [...]string{
+		"in_progress",
+		"won",
+		"draw",
+	}[s]
+}
+
+// Game is the state of a game between the players 1 & 2
+type Game struct {
+	// Piles are the players' cards from the top (index 0) to the bottom
+	Piles [2][]game.Card
+
+	// Table holds the cards left on the table when both players run out of cards during a war
+	Table []game.Card
+
+	Rounds int
+}
+
+// Round is the outcome of a single round
+type Round struct {
+	Number int
+
+	// Played are the cards each player put down in the order they were played; in a war,
+	// every player's face-down cards are followed by their face-up one
+	Played [2][]game.Card
+
+	// Wars is the number of the ties in the round
+	Wars int
+
+	// Winner is the player (1 or 2) who took the cards, 0 if both ran out of cards during a war
+	Winner int
+}
+
+// New deals the cards into two piles one at a time, starting with the player 1
+func New(cards []game.Card) (*Game, error) {
+	if len(cards) < 2 {
+		return nil, fmt.Errorf("%w: war needs at least 2 cards, got %d", game.ErrDeckShort, len(cards))
+	}
+
+	g := &Game{}
+
+	for i, card := range cards {
+		g.Piles[i%2] = append(g.Piles[i%2], card)
+	}
+
+	return g, nil
+}
+
+// Status reports whether the game is still in progress
+func (g *Game) Status() Status {
+	switch {
+	case len(g.Table) != 0 || g.Rounds >= MaxRounds:
+		return StatusDraw
+	case len(g.Piles[0]) == 0 || len(g.Piles[1]) == 0:
+		return StatusWon
+	default:
+		return StatusInProgress
+	}
+}
+
+// Winner returns the player (1 or 2) who won the game, 0 if the game is not won (yet)
+func (g *Game) Winner() int {
+	if g.Status() != StatusWon {
+		return 0
+	}
+
+	if len(g.Piles[0]) == 0 {
+		return 2
+	}
+
+	return 1
+}
+
+// Step plays a single round; the winner of the round puts the played cards to the bottom of their pile
+// in the order they were played
+func (g *Game) Step() (Round, error) {
+	if g.Status() != StatusInProgress {
+		return Round{}, ErrGameOver
+	}
+
+	g.Rounds++
+
+	round := Round{Number: g.Rounds}
+
+	var pot []game.Card
+
+	for {
+		var up [2]game.Card
+
+		// the face-up cards are preceded by the face-down ones in a war, keeping at least one card to turn up
+		for p := range g.Piles {
+			down := 0
+			if round.Wars != 0 {
+				down = min(faceDown, len(g.Piles[p])-1)
+			}
+
+			played := g.Piles[p][:down+1]
+			g.Piles[p] = g.Piles[p][down+1:]
+
+			round.Played[p] = append(round.Played[p], played...)
+			pot = append(pot, played...)
+			up[p] = played[down]
+		}
+
+		if c := game.RankAceHigh.Compare(up[0].Value, up[1].Value); c != 0 {
+			winner := 0
+			if c < 0 {
+				winner = 1
+			}
+
+			g.Piles[winner] = append(g.Piles[winner], pot...)
+			round.Winner = winner + 1
+
+			return round, nil
+		}
+
+		round.Wars++
+
+		// a player who has no cards left for the war loses the round (& the game)
+		switch {
+		case len(g.Piles[0]) == 0 && len(g.Piles[1]) == 0:
+			g.Table = pot
+			return round, nil
+		case len(g.Piles[0]) == 0:
+			g.Piles[1] = append(g.Piles[1], pot...)
+			round.Winner = 2
+			return round, nil
+		case len(g.Piles[1]) == 0:
+			g.Piles[0] = append(g.Piles[0], pot...)
+			round.Winner = 1
+			return round, nil
+		}
+	}
+}
+
+// Play plays the rounds until the game is over & returns the number of the rounds played
+func (g *Game) Play() int {
+	played := 0
+
+	for g.Status() == StatusInProgress {
+		if _, err := g.Step(); err != nil {
+			break
+		}
+
+		played++
+	}
+
+	return played
+}
+
+// Cards returns all of the cards in the game: the player 1's pile, the player 2's pile & the table
+func (g *Game) Cards() []game.Card {
+	cards := make([]game.Card, 0, len(g.Piles[0])+len(g.Piles[1])+len(g.Table))
+
+	cards = append(cards, g.Piles[0]...)
+	cards = append(cards, g.Piles[1]...)
+
+	return append(cards, g.Table...)
+}
+
+// Serialize encodes the game as 'pile-1/pile-2/table/rounds' with the cards in the short form
+func (g *Game) Serialize() string {
+	return strings.Join([]string{
+		serializeCards(g.Piles[0]),
+		serializeCards(g.Piles[1]),
+		serializeCards(g.Table),
+		strconv.Itoa(g.Rounds),
+	}, separator)
+}
+
+// Deserialize decodes a game written by Serialize
+func Deserialize(str string) (*Game, error) {
+	tokens := strings.Split(str, separator)
+	if len(tokens) != 4 {
+		return nil, fmt.Errorf("incorrect number of tokens in the war game %q", str)
+	}
+
+	g := &Game{}
+
+	for i, dst := range []*[]game.Card{&g.Piles[0], &g.Piles[1], &g.Table} {
+		deck, err := game.DeckDeserialize(tokens[i])
+		if err != nil {
+			return nil, fmt.Errorf("could not parse the war game: %w", err)
+		}
+
+		*dst = deck.Cards
+	}
+
+	rounds, err := strconv.Atoi(tokens[3])
+	if err != nil {
+		return nil, fmt.Errorf("could not parse the war game's rounds: %w", err)
+	}
+
+	g.Rounds = rounds
+
+	return g, nil
+}
+
+func serializeCards(cards []game.Card) string {
+	return (&game.Deck{Cards: cards}).Serialize()
+}
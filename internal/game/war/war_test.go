@@ -0,0 +1,141 @@
+package war
+
+import (
+	"testing"
+
+	"github.com/AntonAverchenkov/cards-http-service/internal/game"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newGame(t *testing.T, order string) *Game {
+	t.Helper()
+
+	deck, err := game.DeckDeserialize(order)
+	require.NoError(t, err)
+
+	g, err := New(deck.Cards)
+	require.NoError(t, err)
+
+	return g
+}
+
+func serialize(cards []game.Card) string {
+	return (&game.Deck{Cards: cards}).Serialize()
+}
+
+func TestNew(t *testing.T) {
+	g := newGame(t, "ahkh2c3c4d")
+
+	assert.Equal(t, "ah2c4d", serialize(g.Piles[0]))
+	assert.Equal(t, "kh3c", serialize(g.Piles[1]))
+	assert.Equal(t, StatusInProgress, g.Status())
+
+	deck, err := game.DeckDeserialize("ah")
+	require.NoError(t, err)
+
+	_, err = New(deck.Cards)
+	assert.ErrorIs(t, err, game.ErrDeckShort)
+}
+
+func TestStep(t *testing.T) {
+	// 1: ah qc ; 2: kh 3c
+	g := newGame(t, "ahkhqc3c")
+
+	round, err := g.Step()
+	require.NoError(t, err)
+	assert.Equal(t, 1, round.Number)
+	assert.Equal(t, 1, round.Winner)
+	assert.Zero(t, round.Wars)
+	assert.Equal(t, "qcahkh", serialize(g.Piles[0]))
+	assert.Equal(t, "3c", serialize(g.Piles[1]))
+
+	round, err = g.Step()
+	require.NoError(t, err)
+	assert.Equal(t, 1, round.Winner)
+	assert.Equal(t, "ahkhqc3c", serialize(g.Piles[0]))
+	assert.Empty(t, g.Piles[1])
+
+	assert.Equal(t, StatusWon, g.Status())
+	assert.Equal(t, 1, g.Winner())
+
+	_, err = g.Step()
+	assert.ErrorIs(t, err, ErrGameOver)
+}
+
+func TestWar(t *testing.T) {
+	// 1: as 2c 4c 6c kh ; 2: ad 3c 5c 7c qh
+	g := newGame(t, "asad2c3c4c5c6c7ckhqh")
+
+	round, err := g.Step()
+	require.NoError(t, err)
+	assert.Equal(t, 1, round.Wars)
+	assert.Equal(t, 1, round.Winner)
+	assert.Equal(t, "as2c4c6ckh", serialize(round.Played[0]))
+	assert.Equal(t, "ad3c5c7cqh", serialize(round.Played[1]))
+	assert.Equal(t, "asad2c4c6ckh3c5c7cqh", serialize(g.Piles[0]))
+
+	assert.Equal(t, StatusWon, g.Status())
+}
+
+func TestWarShortPile(t *testing.T) {
+	// 1: as 2c 4c 5c ; 2: ad 3c kh - the player 2 has only 1 card to put face down
+	g := newGame(t, "asad2c3c4ckh5c")
+
+	round, err := g.Step()
+	require.NoError(t, err)
+	assert.Equal(t, "as2c4c5c", serialize(round.Played[0]))
+	assert.Equal(t, "ad3ckh", serialize(round.Played[1]))
+	assert.Equal(t, 2, round.Winner)
+	assert.Equal(t, 2, g.Winner())
+
+	// the player 2 runs out of cards for the war
+	g = newGame(t, "asadkh")
+
+	round, err = g.Step()
+	require.NoError(t, err)
+	assert.Equal(t, 1, round.Winner)
+	assert.Equal(t, "khasad", serialize(g.Piles[0]))
+
+	// both players run out of cards for the war
+	g = newGame(t, "asad")
+
+	round, err = g.Step()
+	require.NoError(t, err)
+	assert.Zero(t, round.Winner)
+	assert.Equal(t, StatusDraw, g.Status())
+	assert.Zero(t, g.Winner())
+	assert.Equal(t, "asad", serialize(g.Cards()))
+}
+
+func TestPlay(t *testing.T) {
+	g, err := New(game.NewDeck().Cards)
+	require.NoError(t, err)
+
+	played := g.Play()
+
+	assert.NotEqual(t, StatusInProgress, g.Status())
+	assert.Equal(t, g.Rounds, played)
+	assert.LessOrEqual(t, played, MaxRounds)
+	assert.ElementsMatch(t, game.NewDeck().Cards, g.Cards())
+}
+
+func TestSerialize(t *testing.T) {
+	g := newGame(t, "asad2c3c4c5c6c7ckhqh9d")
+
+	_, err := g.Step()
+	require.NoError(t, err)
+
+	str := g.Serialize()
+	assert.Equal(t, "9dasad2c4c6ckh3c5c7cqh///1", str)
+
+	restored, err := Deserialize(str)
+	require.NoError(t, err)
+	assert.Equal(t, g.Serialize(), restored.Serialize())
+	assert.Equal(t, StatusWon, restored.Status())
+
+	for _, str := range []string{"ah/kh/3", "ah/kh//x", "zz/kh//1"} {
+		_, err := Deserialize(str)
+		assert.Error(t, err, str)
+	}
+}
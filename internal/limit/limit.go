@@ -0,0 +1,180 @@
+// Package limit implements the token bucket rate limits keyed by the session ids & the client addresses
+package limit
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"sync"
+	"time"
+
+	"golang.org/x/time/rate"
+)
+
+// ErrLimited is matched by the errors returned once a budget is exhausted
+var ErrLimited = errors.New("rate limit exceeded")
+
+// Error reports the exhausted budget & how long to wait before the next attempt could succeed
+type Error struct {
+	Budget     string
+	RetryAfter time.Duration
+}
+
+func (e *Error) Error() string {
+	return fmt.Sprintf("the %s rate limit is exceeded; retry in %v", e.Budget, e.RetryAfter.Round(time.Millisecond))
+}
+
+func (e *Error) Is(target error) bool {
+	return target == ErrLimited
+}
+
+// RetryAfterSeconds rounds the delay up to the whole seconds of the Retry-After header
+func (e *Error) RetryAfterSeconds() int {
+	return int(math.Ceil(e.RetryAfter.Seconds()))
+}
+
+// pruneInterval is how often the idle buckets are looked for
+const pruneInterval = time.Minute
+
+// Limiter keeps a separate token bucket for each key; the zero rate disables the limit
+type Limiter struct {
+	budget string
+	rate   rate.Limit
+	burst  int
+
+	lock    sync.Mutex
+	buckets map[string]*bucket
+	pruned  time.Time
+
+	now func() time.Time
+}
+
+type bucket struct {
+	limiter *rate.Limiter
+	seen    time.Time
+}
+
+// NewLimiter creates a limiter which allows the given number of events per second with the given bursts for each key;
+// the burst has to be positive unless the limit is disabled
+func NewLimiter(budget string, perSecond float64, burst int) *Limiter {
+	return &Limiter{
+		budget:  budget,
+		rate:    rate.Limit(perSecond),
+		burst:   burst,
+		buckets: make(map[string]*bucket),
+		now:     time.Now,
+	}
+}
+
+// Allow takes a token from the key's bucket or returns an *Error if the bucket is empty
+func (l *Limiter) Allow(key string) error {
+	_, err := l.reserve(key)
+	return err
+}
+
+// reservation is a token taken from a bucket, which could be given back while no later tokens are taken
+type reservation struct {
+	reservation *rate.Reservation
+	at          time.Time
+}
+
+// cancel gives the token back; the nil reservations of the disabled limits are no-ops
+func (r *reservation) cancel() {
+	if r != nil {
+		r.reservation.CancelAt(r.at)
+	}
+}
+
+// reserve takes a token from the key's bucket, so that it could be given back if another budget is exhausted
+func (l *Limiter) reserve(key string) (*reservation, error) {
+	if l == nil || l.rate <= 0 {
+		return nil, nil
+	}
+
+	l.lock.Lock()
+	defer l.lock.Unlock()
+
+	now := l.now()
+	l.prune(now)
+
+	b, ok := l.buckets[key]
+	if !ok {
+		b = &bucket{limiter: rate.NewLimiter(l.rate, l.burst)}
+		l.buckets[key] = b
+	}
+	b.seen = now
+
+	r := b.limiter.ReserveN(now, 1)
+	if !r.OK() {
+		// the burst is zero (which the command-line options reject), so there is no point in retrying
+		return nil, &Error{Budget: l.budget}
+	}
+
+	if delay := r.DelayFrom(now); delay > 0 {
+		r.CancelAt(now)
+		return nil, &Error{Budget: l.budget, RetryAfter: delay}
+	}
+
+	return &reservation{reservation: r, at: now}, nil
+}
+
+// Len returns the number of the tracked buckets
+func (l *Limiter) Len() int {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+
+	return len(l.buckets)
+}
+
+// prune drops the buckets which have been idle long enough to refill, since they are no different from new ones
+func (l *Limiter) prune(now time.Time) {
+	if now.Sub(l.pruned) < pruneInterval {
+		return
+	}
+	l.pruned = now
+
+	refill := time.Duration(float64(l.burst) / float64(l.rate) * float64(time.Second))
+
+	for key, b := range l.buckets {
+		if now.Sub(b.seen) > refill {
+			delete(l.buckets, key)
+		}
+	}
+}
+
+// Limits groups the budgets that are shared by the rest & the grpc apis
+type Limits struct {
+	SessionCreate    *Limiter // keyed by the client address
+	Mutation         *Limiter // keyed by the session id
+	MutationByClient *Limiter // keyed by the client address
+}
+
+// CreateSession charges the session creation budget of the client
+func (l *Limits) CreateSession(client string) error {
+	if l == nil {
+		return nil
+	}
+
+	return l.SessionCreate.Allow(client)
+}
+
+// Mutate charges the deck mutation budgets of both the session & the client
+func (l *Limits) Mutate(session, client string) error {
+	if l == nil {
+		return nil
+	}
+
+	// the session's token is given back if the client's budget is exhausted, so that the session is not charged for
+	// the rejected attempt
+	r, err := l.Mutation.reserve(session)
+	if err != nil {
+		return err
+	}
+
+	if err := l.MutationByClient.Allow(client); err != nil {
+		r.cancel()
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,126 @@
+package limit
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeClock lets the tests move the time forward without sleeping
+type fakeClock struct {
+	now time.Time
+}
+
+func (c *fakeClock) Now() time.Time {
+	return c.now
+}
+
+func newTestLimiter(perSecond float64, burst int) (*Limiter, *fakeClock) {
+	clock := &fakeClock{now: time.Unix(1600000000, 0)}
+
+	l := NewLimiter("test", perSecond, burst)
+	l.now = clock.Now
+
+	return l, clock
+}
+
+func TestLimiterAllow(t *testing.T) {
+	l, clock := newTestLimiter(2, 3)
+
+	// the burst is allowed right away
+	for i := 0; i < 3; i++ {
+		require.NoError(t, l.Allow("a"))
+	}
+
+	err := l.Allow("a")
+	require.True(t, errors.Is(err, ErrLimited))
+
+	var limitErr *Error
+	require.True(t, errors.As(err, &limitErr))
+	assert.Equal(t, "test", limitErr.Budget)
+	assert.Equal(t, 500*time.Millisecond, limitErr.RetryAfter)
+	assert.Equal(t, 1, limitErr.RetryAfterSeconds())
+
+	// the other keys have their own buckets
+	assert.NoError(t, l.Allow("b"))
+
+	// the rejected attempts do not consume the tokens
+	clock.now = clock.now.Add(500 * time.Millisecond)
+	assert.NoError(t, l.Allow("a"))
+	assert.Error(t, l.Allow("a"))
+}
+
+func TestLimiterDisabled(t *testing.T) {
+	l, _ := newTestLimiter(0, 0)
+
+	for i := 0; i < 100; i++ {
+		require.NoError(t, l.Allow("a"))
+	}
+
+	var nilLimiter *Limiter
+	assert.NoError(t, nilLimiter.Allow("a"))
+}
+
+func TestLimiterPrune(t *testing.T) {
+	l, clock := newTestLimiter(1, 10)
+
+	require.NoError(t, l.Allow("a"))
+	require.NoError(t, l.Allow("b"))
+	assert.Equal(t, 2, l.Len())
+
+	// "a" has refilled by the time the idle buckets are looked for, while "b" is still in use
+	clock.now = clock.now.Add(pruneInterval)
+	require.NoError(t, l.Allow("b"))
+	assert.Equal(t, 1, l.Len())
+}
+
+func TestLimitsMutate(t *testing.T) {
+	limits := &Limits{
+		Mutation:         NewLimiter("mutation", 1, 2),
+		MutationByClient: NewLimiter("client mutation", 1, 3),
+	}
+
+	// the session budget runs out first
+	require.NoError(t, limits.Mutate("s1", "10.0.0.1"))
+	require.NoError(t, limits.Mutate("s1", "10.0.0.1"))
+
+	var limitErr *Error
+	require.True(t, errors.As(limits.Mutate("s1", "10.0.0.1"), &limitErr))
+	assert.Equal(t, "mutation", limitErr.Budget)
+
+	// switching the sessions does not get around the client budget
+	require.NoError(t, limits.Mutate("s2", "10.0.0.1"))
+	require.True(t, errors.As(limits.Mutate("s3", "10.0.0.1"), &limitErr))
+	assert.Equal(t, "client mutation", limitErr.Budget)
+
+	var nilLimits *Limits
+	assert.NoError(t, nilLimits.Mutate("s1", "10.0.0.1"))
+	assert.NoError(t, nilLimits.CreateSession("10.0.0.1"))
+}
+
+func TestLimitsMutateChargesNeitherOnRejection(t *testing.T) {
+	limits := &Limits{
+		Mutation:         NewLimiter("mutation", 0.001, 2),
+		MutationByClient: NewLimiter("client mutation", 0.001, 1),
+	}
+
+	require.NoError(t, limits.Mutate("s1", "10.0.0.1"))
+
+	// the client budget is exhausted, which gives the session's token back
+	var limitErr *Error
+	require.True(t, errors.As(limits.Mutate("s1", "10.0.0.1"), &limitErr))
+	assert.Equal(t, "client mutation", limitErr.Budget)
+
+	require.NoError(t, limits.Mutate("s1", "10.0.0.2"))
+}
+
+func TestLimiterEmptyBurst(t *testing.T) {
+	l, _ := newTestLimiter(1, 0)
+
+	var limitErr *Error
+	require.True(t, errors.As(l.Allow("a"), &limitErr))
+	assert.Zero(t, limitErr.RetryAfter)
+}
@@ -0,0 +1,172 @@
+package media
+
+import (
+	"bytes"
+	"encoding/csv"
+	"fmt"
+	"io"
+	"strings"
+	"unicode"
+
+	"github.com/AntonAverchenkov/cards-http-service/internal/game"
+)
+
+// csvHeader is the first record of the csv representation
+var csvHeader = []string{"value", "suit"}
+
+//
+// The codecs below only deal with the textual representations; JSON is left to the api layer
+//
+
+// EncodeCards returns the textual representation of the given cards
+func EncodeCards(f Format, cards []game.Card) []byte {
+	switch f {
+	case FormatText:
+		return []byte((&game.Deck{Cards: cards}).Serialize())
+	case FormatGlyph:
+		return joinCards(cards, game.Card.Glyph)
+	case FormatSymbol:
+		return joinCards(cards, game.Card.Symbol)
+	case FormatCSV:
+		return encodeCSV(cards)
+	default:
+		panic(fmt.Sprintf("media: format %d has no textual representation", f))
+	}
+}
+
+// EncodeCard returns the textual representation of a single card
+func EncodeCard(f Format, card game.Card) []byte {
+	return EncodeCards(f, []game.Card{card})
+}
+
+// DecodeCards parses the textual representation of a list of cards
+func DecodeCards(f Format, b []byte) ([]game.Card, error) {
+	switch f {
+	case FormatText:
+		deck, err := game.DeckDeserialize(strings.TrimSpace(string(b)))
+		if err != nil {
+			return nil, err
+		}
+		return deck.Cards, nil
+	case FormatGlyph:
+		return decodeGlyphs(string(b))
+	case FormatSymbol:
+		return decodeFields(string(b))
+	case FormatCSV:
+		return decodeCSV(b)
+	default:
+		panic(fmt.Sprintf("media: format %d has no textual representation", f))
+	}
+}
+
+// DecodeCard parses a single card; the plain text formats accept any form understood by game.ParseCard
+func DecodeCard(f Format, b []byte) (game.Card, error) {
+	if f != FormatCSV {
+		return game.ParseCard(strings.TrimSpace(string(b)))
+	}
+
+	cards, err := decodeCSV(b)
+	if err != nil {
+		return game.Card{}, err
+	}
+
+	if len(cards) != 1 {
+		return game.Card{}, &game.ParseError{Input: string(b), As: "card"}
+	}
+
+	return cards[0], nil
+}
+
+func joinCards(cards []game.Card, format func(game.Card) string) []byte {
+	tokens := make([]string, 0, len(cards))
+
+	for _, card := range cards {
+		tokens = append(tokens, format(card))
+	}
+
+	return []byte(strings.Join(tokens, " "))
+}
+
+func decodeFields(str string) ([]game.Card, error) {
+	var cards []game.Card
+
+	for _, field := range strings.Fields(str) {
+		card, err := game.ParseCard(field)
+		if err != nil {
+			return nil, err
+		}
+
+		cards = append(cards, card)
+	}
+
+	return cards, nil
+}
+
+// decodeGlyphs parses glyphs with or without whitespace in between them
+func decodeGlyphs(str string) ([]game.Card, error) {
+	var cards []game.Card
+
+	for _, r := range str {
+		if unicode.IsSpace(r) {
+			continue
+		}
+
+		card, err := game.ParseCard(string(r))
+		if err != nil {
+			return nil, err
+		}
+
+		cards = append(cards, card)
+	}
+
+	return cards, nil
+}
+
+func encodeCSV(cards []game.Card) []byte {
+	var b bytes.Buffer
+
+	w := csv.NewWriter(&b)
+
+	// writing into a bytes.Buffer does not fail
+	_ = w.Write(csvHeader)
+
+	for _, card := range cards {
+		_ = w.Write([]string{card.Value.String(), card.Suit.String()})
+	}
+
+	w.Flush()
+
+	return b.Bytes()
+}
+
+// decodeCSV parses 'value,suit' records; the header record is optional
+func decodeCSV(b []byte) ([]game.Card, error) {
+	r := csv.NewReader(bytes.NewReader(b))
+	r.FieldsPerRecord = len(csvHeader)
+	r.TrimLeadingSpace = true
+
+	var cards []game.Card
+
+	for first := true; ; first = false {
+		record, err := r.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, &game.ParseError{Input: string(b), As: "csv"}
+		}
+
+		if first && strings.EqualFold(record[0], csvHeader[0]) && strings.EqualFold(record[1], csvHeader[1]) {
+			continue
+		}
+
+		card, err := game.ParseCard(record[0] + " of " + record[1])
+		if err != nil {
+			return nil, err
+		}
+
+		cards = append(cards, card)
+	}
+
+	return cards, nil
+}
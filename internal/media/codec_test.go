@@ -0,0 +1,72 @@
+package media
+
+import (
+	"testing"
+
+	"github.com/AntonAverchenkov/cards-http-service/internal/game"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var hand = []game.Card{
+	{Value: game.ValueAce, Suit: game.SuitHearts},
+	{Value: game.ValueQueen, Suit: game.SuitSpades},
+	{Value: game.ValueTen, Suit: game.SuitDiamonds},
+}
+
+func TestEncodeCards(t *testing.T) {
+	assert.Equal(t, "ahqstd", string(EncodeCards(FormatText, hand)))
+	assert.Equal(t, "🂱 🂭 🃊", string(EncodeCards(FormatGlyph, hand)))
+	assert.Equal(t, "A♥ Q♠ 10♦", string(EncodeCards(FormatSymbol, hand)))
+	assert.Equal(t, "value,suit\nace,hearts\nqueen,spades\nten,diamonds\n", string(EncodeCards(FormatCSV, hand)))
+}
+
+func TestDecodeCards(t *testing.T) {
+	deck := game.NewDeck()
+	deck.Shuffle()
+
+	for _, f := range []Format{FormatText, FormatGlyph, FormatSymbol, FormatCSV} {
+		cards, err := DecodeCards(f, EncodeCards(f, deck.Cards))
+		require.NoError(t, err)
+		assert.Equal(t, deck.Cards, cards)
+	}
+
+	// the glyphs do not need to be separated
+	cards, err := DecodeCards(FormatGlyph, []byte("🂱🂭🃊"))
+	require.NoError(t, err)
+	assert.Equal(t, hand, cards)
+
+	// the csv header is optional
+	cards, err = DecodeCards(FormatCSV, []byte("ace,hearts\nq,s\n10, diamonds\n"))
+	require.NoError(t, err)
+	assert.Equal(t, hand, cards)
+}
+
+func TestDecodeCard(t *testing.T) {
+	for _, str := range []string{"ah", "ace of hearts", "A♥", "🂱", " ah\n"} {
+		card, err := DecodeCard(FormatText, []byte(str))
+		require.NoError(t, err)
+		assert.Equal(t, hand[0], card)
+	}
+
+	card, err := DecodeCard(FormatCSV, []byte("value,suit\nace,hearts\n"))
+	require.NoError(t, err)
+	assert.Equal(t, hand[0], card)
+}
+
+func TestDecodeErrors(t *testing.T) {
+	_, err := DecodeCards(FormatText, []byte("ahq"))
+	assert.Error(t, err)
+
+	_, err = DecodeCards(FormatGlyph, []byte("🂱x"))
+	assert.ErrorIs(t, err, game.ErrCardUnparseable)
+
+	_, err = DecodeCards(FormatSymbol, []byte("A♥ Z♠"))
+	assert.ErrorIs(t, err, game.ErrCardUnparseable)
+
+	_, err = DecodeCards(FormatCSV, []byte("ace,hearts,extra\n"))
+	assert.ErrorIs(t, err, game.ErrCardUnparseable)
+
+	_, err = DecodeCard(FormatCSV, []byte("ace,hearts\nqueen,spades\n"))
+	assert.ErrorIs(t, err, game.ErrCardUnparseable)
+}
@@ -0,0 +1,129 @@
+// Package media implements the content negotiation & the textual representations of cards
+package media
+
+import (
+	"errors"
+	"fmt"
+	"mime"
+	"strconv"
+	"strings"
+)
+
+// Format is one of the supported card representations
+type Format uint8
+
+// Format values
+const (
+	FormatJSON   Format = iota // [{"value": "ace", "suit": "hearts"}, ...]
+	FormatText                 // "ahqs3d" (see game.Deck.Serialize)
+	FormatGlyph                // "🂱 🂭 🃃"
+	FormatSymbol               // "A♥ Q♠ 3♦"
+	FormatCSV                  // "value,suit\nace,hearts\n..."
+)
+
+var (
+	ErrNotAcceptable        = errors.New("none of the accepted media types can be produced")
+	ErrUnsupportedMediaType = errors.New("the media type is not supported")
+)
+
+// ContentType returns the value of the 'Content-Type' header for the format
+func (f Format) ContentType() string {
+	return [...]string{
+		"application/json; charset=utf-8",
+		"text/plain; charset=utf-8",
+		"text/plain; charset=utf-8; format=glyph",
+		"text/plain; charset=utf-8; format=symbol",
+		"text/csv; charset=utf-8; header=present",
+	}[f]
+}
+
+// Negotiate picks the most preferred format out of the media ranges in the given 'Accept' header.
+// An empty header accepts anything, in which case JSON is returned.
+func Negotiate(accept string) (Format, error) {
+	if strings.TrimSpace(accept) == "" {
+		return FormatJSON, nil
+	}
+
+	var (
+		best    Format
+		bestQ   float64
+		matched bool
+	)
+
+	for _, mediaRange := range strings.Split(accept, ",") {
+		mediaType, params, err := mime.ParseMediaType(mediaRange)
+		if err != nil {
+			continue
+		}
+
+		format, ok := match(mediaType, params)
+		if !ok {
+			continue
+		}
+
+		q := 1.0
+		if s, exists := params["q"]; exists {
+			if q, err = strconv.ParseFloat(s, 64); err != nil {
+				continue
+			}
+		}
+
+		// ties are resolved in favour of the media range listed first
+		if q > 0 && (!matched || q > bestQ) {
+			best, bestQ, matched = format, q, true
+		}
+	}
+
+	if !matched {
+		return 0, fmt.Errorf("%w: %q", ErrNotAcceptable, accept)
+	}
+
+	return best, nil
+}
+
+// ParseContentType returns the format of a request body with the given 'Content-Type' header.
+// Bodies without the header are assumed to be JSON.
+func ParseContentType(contentType string) (Format, error) {
+	if strings.TrimSpace(contentType) == "" {
+		return FormatJSON, nil
+	}
+
+	mediaType, params, err := mime.ParseMediaType(contentType)
+	if err != nil {
+		return 0, fmt.Errorf("%w: %v", ErrUnsupportedMediaType, err)
+	}
+
+	if strings.Contains(mediaType, "*") {
+		return 0, fmt.Errorf("%w: %q", ErrUnsupportedMediaType, contentType)
+	}
+
+	format, ok := match(mediaType, params)
+	if !ok {
+		return 0, fmt.Errorf("%w: %q", ErrUnsupportedMediaType, contentType)
+	}
+
+	return format, nil
+}
+
+// match maps a single (possibly wildcard) media type onto a format
+func match(mediaType string, params map[string]string) (Format, bool) {
+	switch mediaType {
+	case "*/*", "application/*", "application/json":
+		return FormatJSON, true
+	case "text/*":
+		return FormatText, true
+	case "text/csv":
+		return FormatCSV, true
+	case "text/plain":
+		switch params["format"] {
+		case "":
+			return FormatText, true
+		case "glyph":
+			return FormatGlyph, true
+		case "symbol":
+			return FormatSymbol, true
+		}
+	}
+
+	return 0, false
+}
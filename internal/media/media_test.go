@@ -0,0 +1,81 @@
+package media
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNegotiate(t *testing.T) {
+	successCases := []struct {
+		accept   string
+		expected Format
+	}{{
+		accept:   "",
+		expected: FormatJSON,
+	}, {
+		accept:   "*/*",
+		expected: FormatJSON,
+	}, {
+		accept:   "text/html,application/xhtml+xml,application/xml;q=0.9,*/*;q=0.8",
+		expected: FormatJSON,
+	}, {
+		accept:   "text/plain",
+		expected: FormatText,
+	}, {
+		accept:   "text/plain; format=glyph",
+		expected: FormatGlyph,
+	}, {
+		accept:   "text/plain;format=symbol, application/json;q=0.5",
+		expected: FormatSymbol,
+	}, {
+		accept:   "application/json;q=0.5, text/csv",
+		expected: FormatCSV,
+	}, {
+		accept:   "text/csv, text/plain",
+		expected: FormatCSV,
+	}, {
+		accept:   "image/png, text/*;q=0.1",
+		expected: FormatText,
+	}}
+
+	for _, test := range successCases {
+		f, err := Negotiate(test.accept)
+		assert.NoError(t, err, test.accept)
+		assert.Equal(t, test.expected, f, test.accept)
+	}
+}
+
+func TestNegotiateErrors(t *testing.T) {
+	failureCases := []string{
+		"image/png",
+		"text/html",
+		"text/plain; format=emoji",
+		"application/json;q=0",
+	}
+
+	for _, test := range failureCases {
+		_, err := Negotiate(test)
+		assert.ErrorIs(t, err, ErrNotAcceptable, test)
+	}
+}
+
+func TestParseContentType(t *testing.T) {
+	f, err := ParseContentType("")
+	assert.NoError(t, err)
+	assert.Equal(t, FormatJSON, f)
+
+	f, err = ParseContentType("text/plain; charset=utf-8")
+	assert.NoError(t, err)
+	assert.Equal(t, FormatText, f)
+
+	f, err = ParseContentType("text/csv")
+	assert.NoError(t, err)
+	assert.Equal(t, FormatCSV, f)
+
+	_, err = ParseContentType("text/*")
+	assert.ErrorIs(t, err, ErrUnsupportedMediaType)
+
+	_, err = ParseContentType("application/xml")
+	assert.ErrorIs(t, err, ErrUnsupportedMediaType)
+}
@@ -0,0 +1,128 @@
+// Package metrics defines the prometheus collectors exposed on GET /metrics
+package metrics
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+const namespace = "cards"
+
+var (
+	requests = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "http_requests_total",
+		Help:      "The number of http requests by the api operation id & the response status code",
+	}, []string{"operation", "code"})
+
+	requestDuration = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Name:      "http_request_duration_seconds",
+		Help:      "The http request latency by the api operation id",
+		Buckets:   prometheus.DefBuckets,
+	}, []string{"operation"})
+
+	cardsDealt = promauto.NewCounter(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "cards_dealt_total",
+		Help:      "The number of cards dealt from all the decks",
+	})
+
+	cardsReturned = promauto.NewCounter(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "cards_returned_total",
+		Help:      "The number of cards returned to all the decks",
+	})
+
+	conflicts = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "conflicts_total",
+		Help:      "The number of 409 conflicts by the problem code",
+	}, []string{"reason"})
+
+	rateLimited = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "rate_limited_total",
+		Help:      "The number of requests rejected by the rate limits by the exhausted budget",
+	}, []string{"budget"})
+
+	sessionsEvicted = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "sessions_evicted_total",
+		Help:      "The number of the least recently used sessions evicted over the cap by whether they were spilled",
+	}, []string{"spilled"})
+
+	sessionsRevived = promauto.NewCounter(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "sessions_revived_total",
+		Help:      "The number of the spilled sessions brought back into memory",
+	})
+
+	persistDuration = promauto.NewGauge(prometheus.GaugeOpts{
+		Namespace: namespace,
+		Name:      "sessions_persist_duration_seconds",
+		Help:      "The time it took to write the last sessions snapshot",
+	})
+
+	persistSize = promauto.NewGauge(prometheus.GaugeOpts{
+		Namespace: namespace,
+		Name:      "sessions_persist_size_bytes",
+		Help:      "The size of the last sessions snapshot",
+	})
+)
+
+// ObserveRequest records a single http request
+func ObserveRequest(operation string, code int, duration time.Duration) {
+	requests.WithLabelValues(operation, strconv.Itoa(code)).Inc()
+	requestDuration.WithLabelValues(operation).Observe(duration.Seconds())
+}
+
+// CardDealt records a card dealt from a deck
+func CardDealt() {
+	cardsDealt.Inc()
+}
+
+// CardReturned records a card returned to a deck
+func CardReturned() {
+	cardsReturned.Inc()
+}
+
+// Conflict records a 409 conflict with the given problem code
+func Conflict(reason string) {
+	conflicts.WithLabelValues(reason).Inc()
+}
+
+// RateLimited counts a request rejected by the given rate limit budget
+func RateLimited(budget string) {
+	rateLimited.WithLabelValues(budget).Inc()
+}
+
+// SessionEvicted counts a session evicted over the cap
+func SessionEvicted(spilled bool) {
+	sessionsEvicted.WithLabelValues(strconv.FormatBool(spilled)).Inc()
+}
+
+// SessionRevived counts a spilled session brought back into memory
+func SessionRevived() {
+	sessionsRevived.Inc()
+}
+
+// ObservePersist records the duration & the size of a sessions snapshot
+func ObservePersist(duration time.Duration, size int64) {
+	persistDuration.Set(duration.Seconds())
+	persistSize.Set(float64(size))
+}
+
+// RegisterSessionCount exposes the number of active sessions; count is called on every scrape
+func RegisterSessionCount(count func() int) {
+	prometheus.MustRegister(prometheus.NewGaugeFunc(prometheus.GaugeOpts{
+		Namespace: namespace,
+		Name:      "sessions_active",
+		Help:      "The number of active sessions",
+	}, func() float64 {
+		return float64(count())
+	}))
+}
@@ -0,0 +1,187 @@
+package render
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/AntonAverchenkov/cards-http-service/internal/game"
+)
+
+// suitShapes are drawn in a 100x100 box so that they could be scaled with <use width height>
+var suitShapes = [...]string{
+	game.SuitClubs: `<circle cx="50" cy="26" r="22"/><circle cx="25" cy="58" r="22"/><circle cx="75" cy="58" r="22"/>` +
+		`<circle cx="50" cy="50" r="12"/><path d="M46 55 C46 80 40 92 30 100 L70 100 C60 92 54 80 54 55 Z"/>`,
+	game.SuitHearts: `<path d="M50 92 C20 66 0 48 0 28 C0 12 12 0 27 0 C38 0 46 7 50 16 C54 7 62 0 73 0 ` +
+		`C88 0 100 12 100 28 C100 48 80 66 50 92 Z"/>`,
+	game.SuitDiamonds: `<path d="M50 0 L88 50 L50 100 L12 50 Z"/>`,
+	game.SuitSpades: `<path d="M50 0 C80 26 100 42 100 60 C100 75 88 84 75 84 C64 84 56 79 53 72 ` +
+		`C54 84 58 92 68 100 L32 100 C42 92 46 84 47 72 C44 79 36 84 25 84 C12 84 0 75 0 60 C0 42 20 26 50 0 Z"/>`,
+}
+
+// The pips are laid out in 3 columns & up to 7 rows within the card's center
+const (
+	pipLeft   = 28.0
+	pipMiddle = 50.0
+	pipRight  = 72.0
+
+	pipTop    = 30.0
+	pipBottom = 110.0
+
+	pipSize = 18.0
+)
+
+// pipRow returns the y coordinate of the given fraction of the distance between the top & bottom rows
+func pipRow(fraction float64) float64 {
+	return pipTop + (pipBottom-pipTop)*fraction
+}
+
+// pipLayouts lists the pip centers for each of the number cards
+var pipLayouts = map[game.Value][][2]float64{
+	game.ValueTwo: {
+		{pipMiddle, pipRow(0)}, {pipMiddle, pipRow(1)},
+	},
+	game.ValueThree: {
+		{pipMiddle, pipRow(0)}, {pipMiddle, pipRow(0.5)}, {pipMiddle, pipRow(1)},
+	},
+	game.ValueFour: {
+		{pipLeft, pipRow(0)}, {pipRight, pipRow(0)},
+		{pipLeft, pipRow(1)}, {pipRight, pipRow(1)},
+	},
+	game.ValueFive: {
+		{pipLeft, pipRow(0)}, {pipRight, pipRow(0)},
+		{pipMiddle, pipRow(0.5)},
+		{pipLeft, pipRow(1)}, {pipRight, pipRow(1)},
+	},
+	game.ValueSix: {
+		{pipLeft, pipRow(0)}, {pipRight, pipRow(0)},
+		{pipLeft, pipRow(0.5)}, {pipRight, pipRow(0.5)},
+		{pipLeft, pipRow(1)}, {pipRight, pipRow(1)},
+	},
+	game.ValueSeven: {
+		{pipLeft, pipRow(0)}, {pipRight, pipRow(0)},
+		{pipMiddle, pipRow(0.25)},
+		{pipLeft, pipRow(0.5)}, {pipRight, pipRow(0.5)},
+		{pipLeft, pipRow(1)}, {pipRight, pipRow(1)},
+	},
+	game.ValueEight: {
+		{pipLeft, pipRow(0)}, {pipRight, pipRow(0)},
+		{pipMiddle, pipRow(0.25)},
+		{pipLeft, pipRow(0.5)}, {pipRight, pipRow(0.5)},
+		{pipMiddle, pipRow(0.75)},
+		{pipLeft, pipRow(1)}, {pipRight, pipRow(1)},
+	},
+	game.ValueNine: {
+		{pipLeft, pipRow(0)}, {pipRight, pipRow(0)},
+		{pipLeft, pipRow(1.0 / 3)}, {pipRight, pipRow(1.0 / 3)},
+		{pipMiddle, pipRow(0.5)},
+		{pipLeft, pipRow(2.0 / 3)}, {pipRight, pipRow(2.0 / 3)},
+		{pipLeft, pipRow(1)}, {pipRight, pipRow(1)},
+	},
+	game.ValueTen: {
+		{pipLeft, pipRow(0)}, {pipRight, pipRow(0)},
+		{pipMiddle, pipRow(1.0 / 6)},
+		{pipLeft, pipRow(1.0 / 3)}, {pipRight, pipRow(1.0 / 3)},
+		{pipLeft, pipRow(2.0 / 3)}, {pipRight, pipRow(2.0 / 3)},
+		{pipMiddle, pipRow(5.0 / 6)},
+		{pipLeft, pipRow(1)}, {pipRight, pipRow(1)},
+	},
+}
+
+// writeDefs writes the suit shapes & the back pattern that are referenced by the cards
+func writeDefs(b *bytes.Buffer) {
+	b.WriteString("<defs>\n")
+
+	for suit := game.SuitClubs; suit < game.SuitsTotalCount; suit++ {
+		fmt.Fprintf(b, `<symbol id="suit-%s" viewBox="0 0 100 100">%s</symbol>`+"\n", suit.ShortString(), suitShapes[suit])
+	}
+
+	b.WriteString(`<pattern id="back-pattern" width="8" height="8" patternUnits="userSpaceOnUse" patternTransform="rotate(45)">` +
+		`<rect width="8" height="8" fill="#1565c0"/><rect width="4" height="8" fill="#1e88e5"/></pattern>` + "\n")
+
+	b.WriteString("</defs>\n")
+}
+
+func writeOutline(b *bytes.Buffer, fill string) {
+	fmt.Fprintf(
+		b,
+		`<rect x="0.5" y="0.5" width="%s" height="%s" rx="6" fill="%s" stroke="#424242"/>`+"\n",
+		num(CardWidth-1), num(CardHeight-1), fill,
+	)
+}
+
+func writeSuit(b *bytes.Buffer, suit game.Suit, cx, cy, size float64) {
+	fmt.Fprintf(
+		b,
+		`<use xlink:href="#suit-%s" x="%s" y="%s" width="%s" height="%s"/>`+"\n",
+		suit.ShortString(), num(cx-size/2), num(cy-size/2), num(size), num(size),
+	)
+}
+
+// writeIndex writes the value & the suit in the top-left corner of the card
+func writeIndex(b *bytes.Buffer, card game.Card) {
+	fmt.Fprintf(
+		b,
+		`<text x="12" y="22" font-family="sans-serif" font-size="18" font-weight="bold" text-anchor="middle">%s</text>`+"\n",
+		card.Value.Symbol(),
+	)
+	writeSuit(b, card.Suit, 12, 33, 12)
+}
+
+func writeFace(b *bytes.Buffer, card game.Card) {
+	writeOutline(b, "#ffffff")
+
+	fmt.Fprintf(b, `<g fill="%s">`+"\n", color(card.Suit))
+
+	// the corner indices are rotated by 180 degrees in the bottom-right corner
+	writeIndex(b, card)
+	fmt.Fprintf(b, `<g transform="rotate(180 %s %s)">`+"\n", num(CardWidth/2), num(CardHeight/2))
+	writeIndex(b, card)
+	b.WriteString("</g>\n")
+
+	switch card.Value {
+	case game.ValueAce:
+		writeSuit(b, card.Suit, CardWidth/2, CardHeight/2, 44)
+
+	case game.ValueJack, game.ValueQueen, game.ValueKing:
+		// the court cards get a framed letter rather than a portrait
+		fmt.Fprintf(b, `<rect x="24" y="24" width="52" height="92" rx="3" fill="none" stroke="%s" stroke-width="1.5"/>`+"\n", color(card.Suit))
+		fmt.Fprintf(
+			b,
+			`<text x="50" y="78" font-family="serif" font-size="40" font-weight="bold" text-anchor="middle">%s</text>`+"\n",
+			card.Value.Symbol(),
+		)
+		writeSuit(b, card.Suit, 50, 100, 14)
+
+	default:
+		for _, pip := range pipLayouts[card.Value] {
+			// the pips in the lower half are upside down
+			if pip[1] > CardHeight/2 {
+				fmt.Fprintf(b, `<g transform="rotate(180 %s %s)">`+"\n", num(pip[0]), num(pip[1]))
+				writeSuit(b, card.Suit, pip[0], pip[1], pipSize)
+				b.WriteString("</g>\n")
+			} else {
+				writeSuit(b, card.Suit, pip[0], pip[1], pipSize)
+			}
+		}
+	}
+
+	b.WriteString("</g>\n")
+}
+
+func writeBack(b *bytes.Buffer) {
+	writeOutline(b, "#ffffff")
+
+	fmt.Fprintf(
+		b,
+		`<rect x="6" y="6" width="%s" height="%s" rx="3" fill="url(#back-pattern)"/>`+"\n",
+		num(CardWidth-12), num(CardHeight-12),
+	)
+}
+
+func writeEmptySlot(b *bytes.Buffer) {
+	fmt.Fprintf(
+		b,
+		`<rect x="0.5" y="0.5" width="%s" height="%s" rx="6" fill="none" stroke="#9e9e9e" stroke-dasharray="6 4"/>`+"\n",
+		num(CardWidth-1), num(CardHeight-1),
+	)
+}
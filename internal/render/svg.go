@@ -0,0 +1,196 @@
+// Package render draws cards as self-contained SVG images (no fonts, images or stylesheets are referenced)
+package render
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"math"
+
+	"github.com/AntonAverchenkov/cards-http-service/internal/game"
+)
+
+// The dimensions of a single card in SVG user units (the 2.5" x 3.5" poker card ratio)
+const (
+	CardWidth  = 100.0
+	CardHeight = 140.0
+)
+
+const (
+	// rowOverlap is the horizontal distance between the cards laid out in a row
+	rowOverlap = 24.0
+
+	// fanStep & fanMax limit the angle between neighbouring cards & the whole fan (in degrees)
+	fanStep = 6.0
+	fanMax  = 120.0
+
+	// fanRadius is the distance from the bottom of the card to the fan's pivot
+	fanRadius = 260.0
+
+	// margin is the padding around the whole image
+	margin = 4.0
+)
+
+const (
+	colorRed   = "#c62828"
+	colorBlack = "#212121"
+)
+
+// Options configure how the cards are rendered
+type Options struct {
+	Fan      bool // fan the cards out in an arc rather than laying them in a row
+	FaceDown bool // show the backs of the cards rather than their faces
+}
+
+// Card renders a single card's face
+func Card(w io.Writer, card game.Card) error {
+	return Hand(w, []game.Card{card}, Options{})
+}
+
+// Back renders a single face-down card
+func Back(w io.Writer) error {
+	return Hand(w, []game.Card{{}}, Options{FaceDown: true})
+}
+
+// Hand renders the given cards in a row or a fan, from left to right; an empty hand is drawn as an empty card slot
+func Hand(w io.Writer, cards []game.Card, opts Options) error {
+	var placements []placement
+
+	if opts.Fan {
+		placements = fan(len(cards))
+	} else {
+		placements = row(len(cards))
+	}
+
+	minX, minY, maxX, maxY := bounds(placements)
+
+	var b bytes.Buffer
+
+	fmt.Fprintf(
+		&b,
+		`<svg xmlns="http://www.w3.org/2000/svg" xmlns:xlink="http://www.w3.org/1999/xlink" width="%s" height="%s" viewBox="%s %s %s %s">`+"\n",
+		num(maxX-minX), num(maxY-minY), num(minX), num(minY), num(maxX-minX), num(maxY-minY),
+	)
+
+	writeDefs(&b)
+
+	if len(cards) == 0 {
+		writeEmptySlot(&b)
+	}
+
+	for i, card := range cards {
+		p := placements[i]
+
+		fmt.Fprintf(&b, `<g transform="translate(%s %s) rotate(%s %s %s)">`+"\n", num(p.x), num(p.y), num(p.angle), num(CardWidth/2), num(CardHeight/2))
+
+		if opts.FaceDown {
+			writeBack(&b)
+		} else {
+			writeFace(&b, card)
+		}
+
+		b.WriteString("</g>\n")
+	}
+
+	b.WriteString("</svg>\n")
+
+	_, err := w.Write(b.Bytes())
+	return err
+}
+
+// placement is the position of a card's top-left corner & its rotation around the card's center
+type placement struct {
+	x, y, angle float64
+}
+
+func row(n int) []placement {
+	placements := make([]placement, n)
+
+	for i := range placements {
+		placements[i] = placement{x: float64(i) * rowOverlap}
+	}
+
+	return placements
+}
+
+func fan(n int) []placement {
+	placements := make([]placement, n)
+
+	step := fanStep
+	if n > 1 && step*float64(n-1) > fanMax {
+		step = fanMax / float64(n-1)
+	}
+
+	// the cards are rotated around a common pivot below the middle of the hand
+	pivotY := CardHeight/2 + fanRadius
+
+	for i := range placements {
+		angle := (float64(i) - float64(n-1)/2) * step
+		rad := angle * math.Pi / 180
+
+		// the card's center moves along the circle around the pivot
+		cx := math.Sin(rad) * fanRadius
+		cy := pivotY - math.Cos(rad)*fanRadius
+
+		placements[i] = placement{
+			x:     cx - CardWidth/2,
+			y:     cy - CardHeight/2,
+			angle: angle,
+		}
+	}
+
+	return placements
+}
+
+// bounds returns the bounding box of all the placed cards, including the margin
+func bounds(placements []placement) (minX, minY, maxX, maxY float64) {
+	if len(placements) == 0 {
+		return -margin, -margin, CardWidth + margin, CardHeight + margin
+	}
+
+	minX, minY = math.Inf(1), math.Inf(1)
+	maxX, maxY = math.Inf(-1), math.Inf(-1)
+
+	for _, p := range placements {
+		rad := p.angle * math.Pi / 180
+		sin, cos := math.Sin(rad), math.Cos(rad)
+
+		for _, corner := range [][2]float64{{0, 0}, {CardWidth, 0}, {0, CardHeight}, {CardWidth, CardHeight}} {
+			// rotate the corner around the card's center & translate it into place
+			dx, dy := corner[0]-CardWidth/2, corner[1]-CardHeight/2
+			x := p.x + CardWidth/2 + dx*cos - dy*sin
+			y := p.y + CardHeight/2 + dx*sin + dy*cos
+
+			minX, maxX = math.Min(minX, x), math.Max(maxX, x)
+			minY, maxY = math.Min(minY, y), math.Max(maxY, y)
+		}
+	}
+
+	return math.Floor(minX - margin), math.Floor(minY - margin), math.Ceil(maxX + margin), math.Ceil(maxY + margin)
+}
+
+// num formats the numbers consistently so that the output is stable across platforms
+func num(f float64) string {
+	s := fmt.Sprintf("%.2f", f)
+
+	// trim the trailing zeroes ("12.50" => "12.5", "12.00" => "12")
+	for s[len(s)-1] == '0' {
+		s = s[:len(s)-1]
+	}
+	if s[len(s)-1] == '.' {
+		s = s[:len(s)-1]
+	}
+	if s == "-0" {
+		s = "0"
+	}
+
+	return s
+}
+
+func color(suit game.Suit) string {
+	if suit == game.SuitHearts || suit == game.SuitDiamonds {
+		return colorRed
+	}
+
+	return colorBlack
+}
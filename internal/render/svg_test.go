@@ -0,0 +1,98 @@
+package render
+
+import (
+	"bytes"
+	"encoding/xml"
+	"flag"
+	"io"
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+
+	"github.com/AntonAverchenkov/cards-http-service/internal/game"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// go test ./internal/render -update
+var update = flag.Bool("update", false, "update the golden files in testdata/")
+
+func TestRenderGolden(t *testing.T) {
+	hand := []game.Card{
+		{Value: game.ValueAce, Suit: game.SuitSpades},
+		{Value: game.ValueSeven, Suit: game.SuitHearts},
+		{Value: game.ValueTen, Suit: game.SuitDiamonds},
+		{Value: game.ValueQueen, Suit: game.SuitClubs},
+		{Value: game.ValueKing, Suit: game.SuitHearts},
+	}
+
+	testCases := []struct {
+		golden string
+		render func(w io.Writer) error
+	}{{
+		golden: "ace-of-spades.svg",
+		render: func(w io.Writer) error { return Card(w, hand[0]) },
+	}, {
+		golden: "ten-of-diamonds.svg",
+		render: func(w io.Writer) error { return Card(w, hand[2]) },
+	}, {
+		golden: "queen-of-clubs.svg",
+		render: func(w io.Writer) error { return Card(w, hand[3]) },
+	}, {
+		golden: "back.svg",
+		render: func(w io.Writer) error { return Back(w) },
+	}, {
+		golden: "hand-row.svg",
+		render: func(w io.Writer) error { return Hand(w, hand, Options{}) },
+	}, {
+		golden: "hand-fan.svg",
+		render: func(w io.Writer) error { return Hand(w, hand, Options{Fan: true}) },
+	}, {
+		golden: "hand-fan-face-down.svg",
+		render: func(w io.Writer) error { return Hand(w, hand, Options{Fan: true, FaceDown: true}) },
+	}, {
+		golden: "empty.svg",
+		render: func(w io.Writer) error { return Hand(w, nil, Options{}) },
+	}}
+
+	for _, test := range testCases {
+		var b bytes.Buffer
+		require.NoError(t, test.render(&b))
+
+		path := filepath.Join("testdata", test.golden)
+
+		if *update {
+			require.NoError(t, ioutil.WriteFile(path, b.Bytes(), 0644))
+		}
+
+		expected, err := ioutil.ReadFile(path)
+		require.NoError(t, err)
+		assert.Equal(t, string(expected), b.String(), test.golden)
+	}
+}
+
+func TestRenderWellFormed(t *testing.T) {
+	deck := game.NewDeck()
+
+	for _, card := range deck.Cards {
+		var b bytes.Buffer
+		require.NoError(t, Card(&b, card))
+		assertWellFormed(t, b.Bytes())
+	}
+
+	var b bytes.Buffer
+	require.NoError(t, Hand(&b, deck.Cards, Options{Fan: true}))
+	assertWellFormed(t, b.Bytes())
+}
+
+func assertWellFormed(t *testing.T, svg []byte) {
+	decoder := xml.NewDecoder(bytes.NewReader(svg))
+
+	for {
+		_, err := decoder.Token()
+		if err == io.EOF {
+			return
+		}
+		require.NoError(t, err)
+	}
+}
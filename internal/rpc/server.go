@@ -0,0 +1,282 @@
+// Package rpc implements the gRPC flavour of the api on top of the same sessions as the rest handlers
+package rpc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"sync"
+
+	"github.com/AntonAverchenkov/cards-http-service/cardspb"
+	"github.com/AntonAverchenkov/cards-http-service/internal/api"
+	"github.com/AntonAverchenkov/cards-http-service/internal/game"
+	"github.com/AntonAverchenkov/cards-http-service/internal/limit"
+	"github.com/AntonAverchenkov/cards-http-service/internal/metrics"
+	"github.com/AntonAverchenkov/cards-http-service/internal/state"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+const (
+	// SessionMetadataKey carries the session id in the request metadata & the response header
+	SessionMetadataKey = "session-id"
+
+	// errorDomain is reported in the ErrorInfo details alongside the problem codes
+	errorDomain = "cards-http-service"
+)
+
+// Server implements cardspb.DeckServer
+type Server struct {
+	cardspb.UnimplementedDeckServer
+
+	lock     *sync.Mutex
+	sessions *state.SessionManager
+	limits   *limit.Limits
+}
+
+// NewServer creates a server which shares the lock, the sessions & the rate limits with the rest handlers
+func NewServer(lock *sync.Mutex, sessions *state.SessionManager, limits *limit.Limits) *Server {
+	return &Server{
+		lock:     lock,
+		sessions: sessions,
+		limits:   limits,
+	}
+}
+
+// Show returns the current state of the deck
+func (s *Server) Show(ctx context.Context, _ *cardspb.ShowRequest) (*cardspb.Cards, error) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	session, err := s.fetchSessionSetHeader(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return fromGameCards(session.Deck.Cards), nil
+}
+
+// Shuffle permutes the deck in an unbiased way
+func (s *Server) Shuffle(ctx context.Context, _ *cardspb.ShuffleRequest) (*cardspb.Cards, error) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	session, err := s.fetchMutableSession(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	session.Deck.Shuffle()
+
+	return fromGameCards(session.Deck.Cards), nil
+}
+
+// Deal deals the top card by removing it from the deck
+func (s *Server) Deal(ctx context.Context, _ *cardspb.DealRequest) (*cardspb.Card, error) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	session, err := s.fetchMutableSession(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	card, err := session.Deck.DealCard()
+	if err != nil {
+		return nil, statusFromError(err)
+	}
+
+	metrics.CardDealt()
+
+	return fromGameCard(card), nil
+}
+
+// Return returns the given card to the back of the deck
+func (s *Server) Return(ctx context.Context, request *cardspb.ReturnRequest) (*cardspb.Cards, error) {
+	card, err := toGameCard(request.GetCard())
+	if err != nil {
+		return nil, statusFromError(err)
+	}
+
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	session, err := s.fetchMutableSession(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := session.Deck.ReturnCard(card); err != nil {
+		return nil, statusFromError(err)
+	}
+
+	metrics.CardReturned()
+
+	return fromGameCards(session.Deck.Cards), nil
+}
+
+// will fetch or create a new session, sending the session id back in the response header if needed
+func (s *Server) fetchSessionSetHeader(ctx context.Context) (state.Session, error) {
+	md, _ := metadata.FromIncomingContext(ctx)
+
+	// the unknown ids (e.g. the forged ones or the ones lost on a restart) get fresh sessions rather than being adopted
+	if ids := md.Get(SessionMetadataKey); len(ids) != 0 && ids[0] != "" {
+		if session, exists := s.sessions.GetSession(ids[0]); exists {
+			return session, nil
+		}
+	}
+
+	if err := s.limits.CreateSession(clientAddress(ctx)); err != nil {
+		return state.Session{}, statusFromError(err)
+	}
+
+	session := s.sessions.CreateSession()
+
+	if err := grpc.SetHeader(ctx, metadata.Pairs(SessionMetadataKey, session.Id)); err != nil {
+		return state.Session{}, status.Errorf(codes.Internal, "could not set the session header: %v", err)
+	}
+
+	return session, nil
+}
+
+// will fetch or create a new session like fetchSessionSetHeader & charge the deck mutation budgets;
+// the deck cannot be changed while its cards are in a game
+func (s *Server) fetchMutableSession(ctx context.Context) (state.Session, error) {
+	session, err := s.fetchSessionSetHeader(ctx)
+	if err != nil {
+		return state.Session{}, err
+	}
+
+	if err := s.limits.Mutate(session.Id, clientAddress(ctx)); err != nil {
+		return state.Session{}, statusFromError(err)
+	}
+
+	if session.Game != nil {
+		return state.Session{}, statusFromError(state.ErrGameInProgress)
+	}
+
+	return session, nil
+}
+
+// clientAddress returns the host of the peer, which the rate limits are keyed by
+func clientAddress(ctx context.Context) string {
+	p, ok := peer.FromContext(ctx)
+	if !ok || p.Addr == nil {
+		return ""
+	}
+
+	host, _, err := net.SplitHostPort(p.Addr.String())
+	if err != nil {
+		return p.Addr.String()
+	}
+
+	return host
+}
+
+// statusFromError maps the game errors onto the grpc status codes; the problem
+// codes of the rest api are attached as the ErrorInfo reasons
+func statusFromError(err error) error {
+	var (
+		code     codes.Code
+		reason   api.ProblemCode
+		limitErr *limit.Error
+	)
+
+	switch {
+	case errors.As(err, &limitErr):
+		return rateLimitedStatus(limitErr)
+	case errors.Is(err, game.ErrDeckEmpty):
+		code, reason = codes.FailedPrecondition, api.ProblemCodeDeckEmpty
+	case errors.Is(err, game.ErrDeckFull):
+		code, reason = codes.FailedPrecondition, api.ProblemCodeDeckFull
+	case errors.Is(err, game.ErrCardDuplicate):
+		code, reason = codes.AlreadyExists, api.ProblemCodeCardDuplicate
+	case errors.Is(err, game.ErrCardForeign):
+		code, reason = codes.FailedPrecondition, api.ProblemCodeCardForeign
+	case errors.Is(err, game.ErrCardUnparseable):
+		code, reason = codes.InvalidArgument, api.ProblemCodeCardUnparseable
+	case errors.Is(err, state.ErrGameInProgress):
+		code, reason = codes.FailedPrecondition, api.ProblemCodeGameInProgress
+	default:
+		return status.Error(codes.Internal, err.Error())
+	}
+
+	// the same conflicts are reported as 409 by the rest api
+	if code == codes.FailedPrecondition || code == codes.AlreadyExists {
+		metrics.Conflict(string(reason))
+	}
+
+	st, detailsErr := status.New(code, err.Error()).WithDetails(&errdetails.ErrorInfo{
+		Reason: string(reason),
+		Domain: errorDomain,
+	})
+	if detailsErr != nil {
+		return status.Error(code, err.Error())
+	}
+
+	return st.Err()
+}
+
+// rateLimitedStatus reports the exhausted budget along with the delay before the next attempt could succeed
+func rateLimitedStatus(err *limit.Error) error {
+	metrics.RateLimited(err.Budget)
+
+	st, detailsErr := status.New(codes.ResourceExhausted, err.Error()).WithDetails(
+		&errdetails.ErrorInfo{
+			Reason: string(api.ProblemCodeRateLimited),
+			Domain: errorDomain,
+		},
+		&errdetails.RetryInfo{
+			RetryDelay: durationpb.New(err.RetryAfter),
+		},
+	)
+	if detailsErr != nil {
+		return status.Error(codes.ResourceExhausted, err.Error())
+	}
+
+	return st.Err()
+}
+
+///
+/// The translation helpers below map between the game package & the protobuf enums (which reserve 0 for 'unspecified')
+///
+
+func fromGameCard(card game.Card) *cardspb.Card {
+	return &cardspb.Card{
+		Value: cardspb.Value(card.Value + 1),
+		Suit:  cardspb.Suit(card.Suit + 1),
+	}
+}
+
+func fromGameCards(cards []game.Card) *cardspb.Cards {
+	result := &cardspb.Cards{
+		Cards: make([]*cardspb.Card, 0, len(cards)),
+	}
+
+	for _, card := range cards {
+		result.Cards = append(result.Cards, fromGameCard(card))
+	}
+
+	return result
+}
+
+func toGameCard(card *cardspb.Card) (game.Card, error) {
+	if card.GetValue() <= cardspb.Value_VALUE_UNSPECIFIED || card.GetValue() > cardspb.Value(game.ValuesTotalCount) {
+		return game.Card{}, fmt.Errorf("error parsing %v: %w", card, &game.ParseError{Input: card.GetValue().String(), As: "card value"})
+	}
+
+	if card.GetSuit() <= cardspb.Suit_SUIT_UNSPECIFIED || card.GetSuit() > cardspb.Suit(game.SuitsTotalCount) {
+		return game.Card{}, fmt.Errorf("error parsing %v: %w", card, &game.ParseError{Input: card.GetSuit().String(), As: "suit"})
+	}
+
+	return game.Card{
+		Value: game.Value(card.GetValue() - 1),
+		Suit:  game.Suit(card.GetSuit() - 1),
+	}, nil
+}
@@ -0,0 +1,221 @@
+package rpc
+
+import (
+	"context"
+	"net"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/AntonAverchenkov/cards-http-service/cardspb"
+	"github.com/AntonAverchenkov/cards-http-service/internal/limit"
+	"github.com/AntonAverchenkov/cards-http-service/internal/state"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+// dial starts the server on an in-memory listener & returns a client connected to it
+func dial(t *testing.T) cardspb.DeckClient {
+	return dialWithLimits(t, nil)
+}
+
+func dialWithLimits(t *testing.T, limits *limit.Limits) cardspb.DeckClient {
+	listener := bufconn.Listen(1024 * 1024)
+
+	server := grpc.NewServer()
+	cardspb.RegisterDeckServer(server, NewServer(&sync.Mutex{}, state.NewSessionManager(), limits))
+
+	go func() {
+		_ = server.Serve(listener)
+	}()
+	t.Cleanup(server.Stop)
+
+	conn, err := grpc.NewClient(
+		"passthrough:///bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			return listener.DialContext(ctx)
+		}),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = conn.Close() })
+
+	return cardspb.NewDeckClient(conn)
+}
+
+// newSession calls Show without a session & returns the context carrying the newly created session id
+func newSession(t *testing.T, client cardspb.DeckClient) context.Context {
+	var header metadata.MD
+
+	cards, err := client.Show(context.Background(), &cardspb.ShowRequest{}, grpc.Header(&header))
+	require.NoError(t, err)
+	require.Len(t, cards.Cards, 52)
+
+	ids := header.Get(SessionMetadataKey)
+	require.Len(t, ids, 1)
+	require.NotEmpty(t, ids[0])
+
+	return metadata.AppendToOutgoingContext(context.Background(), SessionMetadataKey, ids[0])
+}
+
+func assertErrorReason(t *testing.T, err error, code codes.Code, reason string) {
+	st, ok := status.FromError(err)
+	require.True(t, ok)
+	assert.Equal(t, code, st.Code())
+
+	require.Len(t, st.Details(), 1)
+	info, ok := st.Details()[0].(*errdetails.ErrorInfo)
+	require.True(t, ok)
+	assert.Equal(t, reason, info.Reason)
+}
+
+func TestShow(t *testing.T) {
+	client := dial(t)
+	ctx := newSession(t, client)
+
+	cards, err := client.Show(ctx, &cardspb.ShowRequest{})
+	require.NoError(t, err)
+	require.Len(t, cards.Cards, 52)
+
+	assert.Equal(t, cardspb.Value_VALUE_ACE, cards.Cards[0].Value)
+	assert.Equal(t, cardspb.Suit_SUIT_CLUBS, cards.Cards[0].Suit)
+	assert.Equal(t, cardspb.Value_VALUE_KING, cards.Cards[51].Value)
+	assert.Equal(t, cardspb.Suit_SUIT_SPADES, cards.Cards[51].Suit)
+}
+
+func TestShuffle(t *testing.T) {
+	client := dial(t)
+	ctx := newSession(t, client)
+
+	shuffled, err := client.Shuffle(ctx, &cardspb.ShuffleRequest{})
+	require.NoError(t, err)
+	require.Len(t, shuffled.Cards, 52)
+
+	// the session keeps the shuffled order
+	cards, err := client.Show(ctx, &cardspb.ShowRequest{})
+	require.NoError(t, err)
+	assert.Equal(t, shuffled.String(), cards.String())
+}
+
+func TestDealReturn(t *testing.T) {
+	client := dial(t)
+	ctx := newSession(t, client)
+
+	// the deck is full
+	_, err := client.Return(ctx, &cardspb.ReturnRequest{
+		Card: &cardspb.Card{Value: cardspb.Value_VALUE_TWO, Suit: cardspb.Suit_SUIT_HEARTS},
+	})
+	assertErrorReason(t, err, codes.FailedPrecondition, "deck_full")
+
+	card, err := client.Deal(ctx, &cardspb.DealRequest{})
+	require.NoError(t, err)
+	assert.Equal(t, cardspb.Value_VALUE_ACE, card.Value)
+	assert.Equal(t, cardspb.Suit_SUIT_CLUBS, card.Suit)
+
+	// the dealt card is no longer in the deck, so it could be returned to the back
+	_, err = client.Deal(ctx, &cardspb.DealRequest{})
+	require.NoError(t, err)
+
+	cards, err := client.Return(ctx, &cardspb.ReturnRequest{Card: card})
+	require.NoError(t, err)
+	require.Len(t, cards.Cards, 51)
+	assert.Equal(t, card.String(), cards.Cards[50].String())
+
+	// returning it again is a duplicate
+	_, err = client.Return(ctx, &cardspb.ReturnRequest{Card: card})
+	assertErrorReason(t, err, codes.AlreadyExists, "card_duplicate")
+
+	// the card must be specified
+	_, err = client.Return(ctx, &cardspb.ReturnRequest{})
+	assertErrorReason(t, err, codes.InvalidArgument, "card_unparseable")
+
+	_, err = client.Return(ctx, &cardspb.ReturnRequest{Card: &cardspb.Card{Value: cardspb.Value_VALUE_TEN}})
+	assertErrorReason(t, err, codes.InvalidArgument, "card_unparseable")
+}
+
+func TestDealEmpty(t *testing.T) {
+	client := dial(t)
+	ctx := newSession(t, client)
+
+	for i := 0; i < 52; i++ {
+		_, err := client.Deal(ctx, &cardspb.DealRequest{})
+		require.NoError(t, err)
+	}
+
+	_, err := client.Deal(ctx, &cardspb.DealRequest{})
+	assertErrorReason(t, err, codes.FailedPrecondition, "deck_empty")
+}
+
+func TestSessionsAreIsolated(t *testing.T) {
+	client := dial(t)
+
+	ctx1 := newSession(t, client)
+	ctx2 := newSession(t, client)
+
+	_, err := client.Deal(ctx1, &cardspb.DealRequest{})
+	require.NoError(t, err)
+
+	cards1, err := client.Show(ctx1, &cardspb.ShowRequest{})
+	require.NoError(t, err)
+	assert.Len(t, cards1.Cards, 51)
+
+	cards2, err := client.Show(ctx2, &cardspb.ShowRequest{})
+	require.NoError(t, err)
+	assert.Len(t, cards2.Cards, 52)
+}
+
+func TestRateLimits(t *testing.T) {
+	client := dialWithLimits(t, &limit.Limits{
+		SessionCreate: limit.NewLimiter("session creation", 0.001, 1),
+		Mutation:      limit.NewLimiter("mutation", 0.001, 2),
+	})
+	ctx := newSession(t, client)
+
+	// the second session exceeds the creation budget of the client
+	_, err := client.Show(context.Background(), &cardspb.ShowRequest{})
+	assert.Equal(t, codes.ResourceExhausted, status.Code(err))
+
+	for i := 0; i < 2; i++ {
+		_, err := client.Deal(ctx, &cardspb.DealRequest{})
+		require.NoError(t, err)
+	}
+
+	_, err = client.Shuffle(ctx, &cardspb.ShuffleRequest{})
+
+	st, ok := status.FromError(err)
+	require.True(t, ok)
+	assert.Equal(t, codes.ResourceExhausted, st.Code())
+
+	require.Len(t, st.Details(), 2)
+	info, ok := st.Details()[0].(*errdetails.ErrorInfo)
+	require.True(t, ok)
+	assert.Equal(t, "rate_limited", info.Reason)
+	retry, ok := st.Details()[1].(*errdetails.RetryInfo)
+	require.True(t, ok)
+	assert.Greater(t, retry.RetryDelay.AsDuration(), time.Duration(0))
+
+	// reading the deck does not count against the mutation budget
+	_, err = client.Show(ctx, &cardspb.ShowRequest{})
+	assert.NoError(t, err)
+}
+
+func TestUnknownSessionGetsFreshOne(t *testing.T) {
+	client := dial(t)
+
+	var header metadata.MD
+
+	ctx := metadata.AppendToOutgoingContext(context.Background(), SessionMetadataKey, "forged")
+	_, err := client.Show(ctx, &cardspb.ShowRequest{}, grpc.Header(&header))
+	require.NoError(t, err)
+
+	ids := header.Get(SessionMetadataKey)
+	require.Len(t, ids, 1)
+	assert.NotEqual(t, "forged", ids[0])
+}
@@ -2,35 +2,69 @@ package state
 
 import (
 	"bufio"
+	"errors"
 	"fmt"
 	"os"
+	"path/filepath"
 	"strings"
 
 	"github.com/AntonAverchenkov/cards-http-service/internal/game"
+	"github.com/AntonAverchenkov/cards-http-service/internal/game/gofish"
+	"github.com/AntonAverchenkov/cards-http-service/internal/game/klondike"
+	"github.com/AntonAverchenkov/cards-http-service/internal/game/war"
 	"github.com/hashicorp/go-multierror"
 )
 
-// Persist will write sessions information to the given file
-func (s *SessionManager) Persist(path string) (errs error) {
-	f, err := os.Create(path)
+// Persist will write sessions information to the given file, replacing it atomically (so that a snapshot taken
+// while the service is running does not leave a partial file behind)
+func (s *SessionManager) Persist(path string) error {
+	tmp, err := os.CreateTemp(filepath.Dir(path), filepath.Base(path)+".*")
 	if err != nil {
 		return fmt.Errorf("could not create %q: %w", path, err)
 	}
+
+	renamed := false
 	defer func() {
-		if err := f.Close(); err != nil {
-			errs = multierror.Append(errs, fmt.Errorf("could not close %q: %w", path, err))
+		if !renamed {
+			_ = os.Remove(tmp.Name())
 		}
 	}()
 
-	for _, session := range s.sessions {
-		if _, err := fmt.Fprintf(f, "%s %s\n", session.Id, session.Deck.Serialize()); err != nil {
+	writer := bufio.NewWriter(tmp)
+
+	// the least recently used sessions go first, so that the restored sessions keep their order
+	err = s.each(func(session Session) error {
+		if _, err := writer.WriteString(formatSession(session)); err != nil {
 			return fmt.Errorf("could not write to %q file: %w", path, err)
 		}
+
+		return nil
+	})
+	if err == nil {
+		if err = writer.Flush(); err != nil {
+			err = fmt.Errorf("could not write to %q file: %w", path, err)
+		}
+	}
+
+	if closeErr := tmp.Close(); closeErr != nil {
+		err = multierror.Append(err, fmt.Errorf("could not close %q: %w", path, closeErr))
+	}
+	if err != nil {
+		return err
+	}
+
+	if err := os.Rename(tmp.Name(), path); err != nil {
+		return fmt.Errorf("could not replace %q: %w", path, err)
 	}
 
+	renamed = true
+
 	return nil
 }
 
+// maxLineLength bounds the length of a persisted session
+const maxLineLength = 16 * 1024 * 1024
+
 // Restore will restore sessions from the given file
 func Restore(path string) (_ *SessionManager, errs error) {
 	f, err := os.Open(path)
@@ -43,35 +77,107 @@ func Restore(path string) (_ *SessionManager, errs error) {
 		}
 	}()
 
+	// the lines of the games may well be longer than the scanner's default limit
 	scanner := bufio.NewScanner(f)
+	scanner.Buffer(make([]byte, 0, 64*1024), maxLineLength)
 
-	sessions := make(map[string]Session, 0)
+	sessions := NewSessionManager()
 
 	for scanner.Scan() {
-		line := scanner.Text()
+		session, err := parseSession(scanner.Text())
+		if err != nil {
+			return nil, fmt.Errorf("%q: %w", path, err)
+		}
 
-		tokens := strings.Split(line, " ")
+		sessions.add(session)
+	}
 
-		if len(tokens) != 2 {
-			return nil, fmt.Errorf("%q has incorrect number of tokens", path)
-		}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("could not read %q %w", path, err)
+	}
 
-		deck, err := game.DeckDeserialize(tokens[1])
-		if err != nil {
-			return nil, fmt.Errorf("%q: deck could not be parsed: %w", path, err)
+	return sessions, nil
+}
+
+// formatSession encodes the session as a 'session-id serialized-deck-string [composition] [game=state]' line;
+// the composition is left out for the standard decks, so that the older files remain readable
+func formatSession(session Session) string {
+	tokens := []string{session.Id, session.Deck.Serialize()}
+
+	if composition := session.Deck.Composition(); composition != game.CompositionStandard {
+		tokens = append(tokens, composition.String())
+	}
+
+	switch g := session.Game.(type) {
+	case *war.Game:
+		tokens = append(tokens, "war="+g.Serialize())
+	case *klondike.Game:
+		tokens = append(tokens, "klondike="+g.Serialize())
+	case *gofish.Game:
+		tokens = append(tokens, "gofish="+g.Serialize())
+	}
+
+	return strings.Join(tokens, " ") + "\n"
+}
+
+// parseSession decodes a line written by formatSession
+func parseSession(line string) (Session, error) {
+	tokens := strings.Split(line, " ")
+
+	if len(tokens) < 2 || len(tokens) > 4 {
+		return Session{}, errors.New("incorrect number of tokens")
+	}
+
+	composition := game.CompositionStandard
+
+	var played Game
+
+	for _, token := range tokens[2:] {
+		name, state, isGame := strings.Cut(token, "=")
+		if !isGame {
+			var err error
+			if composition, err = game.ParseComposition(token); err != nil {
+				return Session{}, fmt.Errorf("deck could not be parsed: %w", err)
+			}
+
+			continue
 		}
 
-		sessions[tokens[0]] = Session{
-			Id:   tokens[0],
-			Deck: deck,
+		switch name {
+		case "war":
+			g, err := war.Deserialize(state)
+			if err != nil {
+				return Session{}, err
+			}
+
+			played = g
+		case "klondike":
+			g, err := klondike.Deserialize(state)
+			if err != nil {
+				return Session{}, err
+			}
+
+			played = g
+		case "gofish":
+			g, err := gofish.Deserialize(state)
+			if err != nil {
+				return Session{}, err
+			}
+
+			played = g
+		default:
+			return Session{}, fmt.Errorf("unknown game %q", name)
 		}
 	}
 
-	if err := scanner.Err(); err != nil {
-		return nil, fmt.Errorf("could not read %q %w", path, err)
+	deck, err := game.DeckDeserialize(tokens[1], game.WithComposition(composition))
+	if err != nil {
+		return Session{}, fmt.Errorf("deck could not be parsed: %w", err)
 	}
 
-	return &SessionManager{
-		sessions: sessions,
+	return Session{
+		Id:   tokens[0],
+		Deck: deck,
+		Game: played,
 	}, nil
 }
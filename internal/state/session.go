@@ -1,9 +1,28 @@
 package state
 
-import "github.com/AntonAverchenkov/cards-http-service/internal/game"
+import (
+	"errors"
+
+	"github.com/AntonAverchenkov/cards-http-service/internal/game"
+)
+
+// Sentinel errors that can be matched with errors.Is
+var (
+	ErrGameInProgress = errors.New("a game is being played with the deck's cards")
+	ErrGameNotFound   = errors.New("there is no such game being played in the session")
+)
 
 // Session represents a persistent connection with a client, each client will get their own deck
 type Session struct {
 	Id   string
 	Deck *game.Deck
+
+	// Game is the game being played with the cards taken out of the deck (nil if there is none)
+	Game Game
+}
+
+// Game is a card game played with the session's cards (e.g. *war.Game, *klondike.Game or *gofish.Game)
+type Game interface {
+	// Cards returns all of the cards in play, which go back to the deck when the game ends
+	Cards() []game.Card
 }
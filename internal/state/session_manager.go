@@ -1,26 +1,64 @@
 package state
 
 import (
+	"container/list"
 	"crypto/rand"
 	"encoding/base64"
 	"io"
+	"log/slog"
+	"sort"
 
 	"github.com/AntonAverchenkov/cards-http-service/internal/game"
+	"github.com/AntonAverchenkov/cards-http-service/internal/metrics"
 )
 
 // SessionManager maintains a collection of currently active sessions
 type SessionManager struct {
-	sessions map[string]Session
+	sessions map[string]*list.Element
+
+	// recency orders the sessions from the most to the least recently used
+	recency *list.List
+
+	// maxSessions caps the number of sessions (0 means no cap); the least recently used ones are evicted into the spill
+	maxSessions int
+	spill       *Spill
+
+	// signer signs the new ids & rejects the ones that were not signed (unless adoptUnsigned allows the existing ones)
+	signer        *Signer
+	adoptUnsigned bool
 }
 
 func NewSessionManager() *SessionManager {
 	return &SessionManager{
-		sessions: make(map[string]Session, 0),
+		sessions: make(map[string]*list.Element, 0),
+		recency:  list.New(),
 	}
 }
 
+// SetMaxSessions caps the number of sessions, evicting the least recently used ones right away if needed;
+// the evicted sessions are written to the spill (if it is not nil) & brought back when they are used again
+func (s *SessionManager) SetMaxSessions(max int, spill *Spill) {
+	s.maxSessions = max
+	s.spill = spill
+
+	s.evict()
+}
+
+// SetSigner makes the manager sign the new session ids & only find the sessions by the signed ids; adoptUnsigned
+// keeps the existing sessions (e.g. the ones restored from a file written before the ids were signed) reachable
+func (s *SessionManager) SetSigner(signer *Signer, adoptUnsigned bool) {
+	s.signer = signer
+	s.adoptUnsigned = adoptUnsigned
+}
+
 func (s *SessionManager) CreateSession() Session {
-	return s.CreateSessionWith(generateUniqueSessionId())
+	id := generateUniqueSessionId()
+
+	if s.signer != nil {
+		id = s.signer.Sign(id)
+	}
+
+	return s.CreateSessionWith(id)
 }
 
 func (s *SessionManager) CreateSessionWith(id string) Session {
@@ -29,19 +67,190 @@ func (s *SessionManager) CreateSessionWith(id string) Session {
 		Deck: game.NewDeck(),
 	}
 
-	s.sessions[id] = session
+	s.add(session)
 
 	return session
 }
 
-// GetOrCreateSession returns a session for the given id if it exists, creates it otherwise
-func (s *SessionManager) GetOrCreateSession(id string) Session {
-	session, exists := s.sessions[id]
+// GetSession returns the session for the given id if it exists (including the spilled sessions)
+func (s *SessionManager) GetSession(id string) (Session, bool) {
+	if s.signer != nil && !s.adoptUnsigned && !s.signer.Verify(id) {
+		return Session{}, false
+	}
+
+	if element, exists := s.sessions[id]; exists {
+		s.recency.MoveToFront(element)
+		return element.Value.(Session), true
+	}
+
+	return s.revive(id)
+}
+
+// List returns up to limit sessions in memory ordered by their ids, starting after the given id;
+// more reports whether there are any sessions left for the next page
+func (s *SessionManager) List(after string, limit int) (page []Session, more bool) {
+	ids := make([]string, 0, len(s.sessions))
+	for id := range s.sessions {
+		if id > after {
+			ids = append(ids, id)
+		}
+	}
+
+	sort.Strings(ids)
+
+	if len(ids) > limit {
+		ids, more = ids[:limit], true
+	}
+
+	page = make([]Session, 0, len(ids))
+	for _, id := range ids {
+		page = append(page, s.sessions[id].Value.(Session))
+	}
+
+	return page, more
+}
+
+// Find returns the session (including a spilled one) without checking its id's signature or marking it as used,
+// so that a spilled session stays in the spill
+func (s *SessionManager) Find(id string) (Session, bool) {
+	if element, exists := s.sessions[id]; exists {
+		return element.Value.(Session), true
+	}
+
+	return s.readSpilled(id)
+}
+
+// Reset replaces the session's deck with a new sorted one of the same composition, ending its game; a spilled
+// session is brought back first, so that the new deck is kept
+func (s *SessionManager) Reset(id string) (Session, bool) {
+	var session Session
+
+	if element, exists := s.sessions[id]; exists {
+		session = element.Value.(Session)
+	} else if session, exists = s.revive(id); !exists {
+		return Session{}, false
+	}
+
+	*session.Deck = *game.NewDeck(game.WithComposition(session.Deck.Composition()))
+
+	if session.Game != nil {
+		session, _ = s.SetGame(id, nil)
+	}
+
+	return session, true
+}
+
+// SetGame starts (or ends, if g is nil) a game in the session
+func (s *SessionManager) SetGame(id string, g Game) (Session, bool) {
+	element, exists := s.sessions[id]
+	if !exists {
+		return Session{}, false
+	}
+
+	session := element.Value.(Session)
+	session.Game = g
+	element.Value = session
+
+	return session, true
+}
+
+// Delete removes the session from memory & from the spill, so that it is not brought back
+func (s *SessionManager) Delete(id string) (bool, error) {
+	element, exists := s.sessions[id]
 	if exists {
-		return session
+		s.recency.Remove(element)
+		delete(s.sessions, id)
 	}
 
-	return s.CreateSessionWith(id)
+	if s.spill != nil {
+		spilled, err := s.spill.Delete(id)
+		exists = exists || spilled
+
+		if err != nil {
+			return exists, err
+		}
+	}
+
+	return exists, nil
+}
+
+// Len returns the number of sessions
+func (s *SessionManager) Len() int {
+	return len(s.sessions)
+}
+
+// each calls f for every session from the least to the most recently used one
+func (s *SessionManager) each(f func(Session) error) error {
+	for element := s.recency.Back(); element != nil; element = element.Prev() {
+		if err := f(element.Value.(Session)); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// add makes the session the most recently used one & evicts the least recently used ones over the cap
+func (s *SessionManager) add(session Session) {
+	if element, exists := s.sessions[session.Id]; exists {
+		s.recency.Remove(element)
+	}
+
+	s.sessions[session.Id] = s.recency.PushFront(session)
+
+	s.evict()
+}
+
+func (s *SessionManager) evict() {
+	if s.maxSessions <= 0 {
+		return
+	}
+
+	for len(s.sessions) > s.maxSessions {
+		session := s.recency.Remove(s.recency.Back()).(Session)
+		delete(s.sessions, session.Id)
+
+		spilled := false
+
+		if s.spill != nil {
+			if err := s.spill.Write(session); err != nil {
+				slog.Warn("could not spill the evicted session; dropping it", "error", err)
+			} else {
+				spilled = true
+			}
+		}
+
+		metrics.SessionEvicted(spilled)
+	}
+}
+
+// revive brings a session back from the spill
+func (s *SessionManager) revive(id string) (Session, bool) {
+	session, found := s.readSpilled(id)
+	if !found {
+		return Session{}, false
+	}
+
+	metrics.SessionRevived()
+
+	s.add(session)
+
+	return session, true
+}
+
+// readSpilled reads a session from the spill, leaving it there
+func (s *SessionManager) readSpilled(id string) (Session, bool) {
+	if s.spill == nil {
+		return Session{}, false
+	}
+
+	session, found, err := s.spill.Read(id)
+	if err != nil {
+		slog.Warn("could not read the spilled sessions", "error", err)
+		return Session{}, false
+	}
+
+	return session, found
 }
 
 func generateUniqueSessionId() string {
@@ -0,0 +1,411 @@
+package state
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/AntonAverchenkov/cards-http-service/internal/game"
+	"github.com/AntonAverchenkov/cards-http-service/internal/game/gofish"
+	"github.com/AntonAverchenkov/cards-http-service/internal/game/klondike"
+	"github.com/AntonAverchenkov/cards-http-service/internal/game/war"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSessionManagerEvictsLeastRecentlyUsed(t *testing.T) {
+	sessions := NewSessionManager()
+	sessions.SetMaxSessions(2, nil)
+
+	sessions.CreateSessionWith("a")
+	sessions.CreateSessionWith("b")
+
+	// "a" becomes the most recently used one, so "b" is evicted
+	_, exists := sessions.GetSession("a")
+	require.True(t, exists)
+
+	sessions.CreateSessionWith("c")
+	assert.Equal(t, 2, sessions.Len())
+
+	_, exists = sessions.GetSession("b")
+	assert.False(t, exists)
+	_, exists = sessions.GetSession("a")
+	assert.True(t, exists)
+	_, exists = sessions.GetSession("c")
+	assert.True(t, exists)
+}
+
+func TestSessionManagerRevivesSpilledSessions(t *testing.T) {
+	spill, err := OpenSpill(filepath.Join(t.TempDir(), "spill"))
+	require.NoError(t, err)
+	defer spill.Close()
+
+	sessions := NewSessionManager()
+	sessions.SetMaxSessions(1, spill)
+
+	a := sessions.CreateSessionWith("a")
+	_, err = a.Deck.DealCard()
+	require.NoError(t, err)
+
+	// "a" is spilled & comes back with its deck, spilling "b" in turn
+	sessions.CreateSessionWith("b")
+	assert.Equal(t, 1, sessions.Len())
+
+	revived, exists := sessions.GetSession("a")
+	require.True(t, exists)
+	assert.Len(t, revived.Deck.Cards, 51)
+
+	// the last record of a session spilled multiple times is the current one
+	_, err = revived.Deck.DealCard()
+	require.NoError(t, err)
+
+	_, exists = sessions.GetSession("b")
+	require.True(t, exists)
+
+	revived, exists = sessions.GetSession("a")
+	require.True(t, exists)
+	assert.Len(t, revived.Deck.Cards, 50)
+
+	_, exists = sessions.GetSession("unknown")
+	assert.False(t, exists)
+}
+
+func TestPersistRestoreKeepsRecency(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "sessions")
+
+	sessions := NewSessionManager()
+	sessions.CreateSessionWith("a")
+	sessions.CreateSessionWith("b")
+	sessions.CreateSessionWith("c")
+
+	_, exists := sessions.GetSession("a")
+	require.True(t, exists)
+
+	require.NoError(t, sessions.Persist(path))
+
+	restored, err := Restore(path)
+	require.NoError(t, err)
+	assert.Equal(t, 3, restored.Len())
+
+	// "b" is the least recently used one
+	restored.SetMaxSessions(2, nil)
+
+	_, exists = restored.GetSession("b")
+	assert.False(t, exists)
+	_, exists = restored.GetSession("a")
+	assert.True(t, exists)
+}
+
+func TestPersistReplacesSnapshot(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "sessions")
+
+	sessions := NewSessionManager()
+	sessions.CreateSessionWith("a")
+	require.NoError(t, sessions.Persist(path))
+
+	sessions.CreateSessionWith("b")
+	require.NoError(t, sessions.Persist(path))
+
+	restored, err := Restore(path)
+	require.NoError(t, err)
+	assert.Equal(t, 2, restored.Len())
+
+	// the snapshot is written aside & renamed over the previous one
+	entries, err := os.ReadDir(dir)
+	require.NoError(t, err)
+	assert.Len(t, entries, 1)
+}
+
+func TestPersistRestoreKeepsComposition(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "sessions")
+
+	sessions := NewSessionManager()
+	sessions.CreateSessionWith("standard")
+
+	piquet := sessions.CreateSessionWith("piquet")
+	*piquet.Deck = *game.NewDeck(game.WithComposition(game.CompositionPiquet))
+
+	require.NoError(t, sessions.Persist(path))
+
+	restored, err := Restore(path)
+	require.NoError(t, err)
+
+	session, exists := restored.GetSession("standard")
+	require.True(t, exists)
+	assert.Equal(t, game.CompositionStandard, session.Deck.Composition())
+
+	session, exists = restored.GetSession("piquet")
+	require.True(t, exists)
+	assert.Equal(t, game.CompositionPiquet, session.Deck.Composition())
+	assert.Len(t, session.Deck.Cards, 32)
+}
+
+func TestPersistRestoreKeepsGame(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "sessions")
+
+	sessions := NewSessionManager()
+	session := sessions.CreateSessionWith("war")
+
+	g, err := war.New(session.Deck.Cards)
+	require.NoError(t, err)
+	session.Deck.Cards = nil
+
+	_, err = g.Step()
+	require.NoError(t, err)
+
+	_, exists := sessions.SetGame("war", g)
+	require.True(t, exists)
+
+	solitaire := sessions.CreateSessionWith("klondike")
+
+	k, err := klondike.New(solitaire.Deck.Cards, 3)
+	require.NoError(t, err)
+	solitaire.Deck.Cards = nil
+
+	sessions.SetGame("klondike", k)
+
+	table := sessions.CreateSessionWith("gofish")
+
+	f, err := gofish.New(table.Deck.Cards, []gofish.Player{{Name: "ann"}, {Name: "bob", Bot: gofish.Memory{}}})
+	require.NoError(t, err)
+	table.Deck.Cards = nil
+
+	sessions.SetGame("gofish", f)
+
+	require.NoError(t, sessions.Persist(path))
+
+	restored, err := Restore(path)
+	require.NoError(t, err)
+
+	session, exists = restored.GetSession("war")
+	require.True(t, exists)
+	assert.Empty(t, session.Deck.Cards)
+	require.IsType(t, &war.Game{}, session.Game)
+	assert.Equal(t, g.Serialize(), session.Game.(*war.Game).Serialize())
+
+	session, exists = restored.GetSession("klondike")
+	require.True(t, exists)
+	require.IsType(t, &klondike.Game{}, session.Game)
+	assert.Equal(t, k.Serialize(), session.Game.(*klondike.Game).Serialize())
+
+	session, exists = restored.GetSession("gofish")
+	require.True(t, exists)
+	require.IsType(t, &gofish.Game{}, session.Game)
+	assert.Equal(t, f.Serialize(), session.Game.(*gofish.Game).Serialize())
+
+	// resetting the deck ends the game
+	session, _ = restored.Reset("war")
+	assert.Nil(t, session.Game)
+	assert.Len(t, session.Deck.Cards, 52)
+}
+
+func TestPersistRestoreLongGoFishGame(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "sessions")
+
+	f, err := gofish.New(game.NewDeck().Cards, []gofish.Player{{Name: "ann"}, {Name: "bob"}})
+	require.NoError(t, err)
+
+	_, err = f.Ask(0, 1, f.Players[0].Hand[0].Value)
+	require.NoError(t, err)
+
+	// a game persisted with its whole log of 5000 turns, before the turns were counted, is longer than 64KiB
+	tokens := strings.Split(f.Serialize(), "/")
+	tokens[0] = strconv.Itoa(f.Current)
+	tokens[2] = strings.TrimSuffix(strings.Repeat(tokens[2]+",", 5000), ",")
+
+	line := fmt.Sprintf("gofish %s gofish=%s\n", game.NewDeck().Serialize(), strings.Join(tokens, "/"))
+	require.Greater(t, len(line), 64*1024)
+	require.NoError(t, os.WriteFile(path, []byte(line), 0o600))
+
+	restored, err := Restore(path)
+	require.NoError(t, err)
+
+	session, exists := restored.GetSession("gofish")
+	require.True(t, exists)
+	require.IsType(t, &gofish.Game{}, session.Game)
+	assert.Equal(t, 5000, session.Game.(*gofish.Game).Played)
+
+	// the game is persisted with the turns the bots need only
+	require.NoError(t, restored.Persist(path))
+
+	info, err := os.Stat(path)
+	require.NoError(t, err)
+	assert.Less(t, info.Size(), int64(8*1024))
+}
+
+func TestSessionManagerList(t *testing.T) {
+	sessions := NewSessionManager()
+	for _, id := range []string{"c", "a", "d", "b", "e"} {
+		sessions.CreateSessionWith(id)
+	}
+
+	ids := func(page []Session) (result []string) {
+		for _, session := range page {
+			result = append(result, session.Id)
+		}
+		return result
+	}
+
+	page, more := sessions.List("", 2)
+	assert.Equal(t, []string{"a", "b"}, ids(page))
+	assert.True(t, more)
+
+	page, more = sessions.List("b", 2)
+	assert.Equal(t, []string{"c", "d"}, ids(page))
+	assert.True(t, more)
+
+	page, more = sessions.List("d", 2)
+	assert.Equal(t, []string{"e"}, ids(page))
+	assert.False(t, more)
+}
+
+func TestSessionManagerResetDelete(t *testing.T) {
+	spill, err := OpenSpill(filepath.Join(t.TempDir(), "spill"))
+	require.NoError(t, err)
+	defer spill.Close()
+
+	sessions := NewSessionManager()
+	sessions.SetMaxSessions(1, spill)
+
+	a := sessions.CreateSessionWith("a")
+	_, err = a.Deck.DealCard()
+	require.NoError(t, err)
+
+	reset, exists := sessions.Reset("a")
+	require.True(t, exists)
+	assert.Len(t, reset.Deck.Cards, 52)
+
+	// spill "a" & bring it back, so that the spill has a record of it
+	sessions.CreateSessionWith("b")
+	_, exists = sessions.GetSession("a")
+	require.True(t, exists)
+
+	deleted, err := sessions.Delete("a")
+	require.NoError(t, err)
+	assert.True(t, deleted)
+
+	// the deleted session is not brought back from the spill
+	_, exists = sessions.GetSession("a")
+	assert.False(t, exists)
+
+	deleted, err = sessions.Delete("a")
+	require.NoError(t, err)
+	assert.False(t, deleted)
+
+	_, exists = sessions.Reset("a")
+	assert.False(t, exists)
+}
+
+func TestSessionManagerFindResetSpilledSessions(t *testing.T) {
+	spill, err := OpenSpill(filepath.Join(t.TempDir(), "spill"))
+	require.NoError(t, err)
+	defer spill.Close()
+
+	sessions := NewSessionManager()
+	sessions.SetMaxSessions(1, spill)
+
+	// "a" is only in the spill
+	a := sessions.CreateSessionWith("a")
+	_, err = a.Deck.DealCard()
+	require.NoError(t, err)
+
+	sessions.CreateSessionWith("b")
+
+	// finding "a" leaves it in the spill, so that "b" is not evicted
+	found, exists := sessions.Find("a")
+	require.True(t, exists)
+	assert.Len(t, found.Deck.Cards, 51)
+
+	_, exists = sessions.sessions["b"]
+	assert.True(t, exists)
+
+	// resetting "a" brings it back with the new deck
+	reset, exists := sessions.Reset("a")
+	require.True(t, exists)
+	assert.Len(t, reset.Deck.Cards, 52)
+
+	found, exists = sessions.Find("a")
+	require.True(t, exists)
+	assert.Len(t, found.Deck.Cards, 52)
+
+	_, exists = sessions.Find("unknown")
+	assert.False(t, exists)
+}
+
+func TestSessionManagerDeletesSpilledSessions(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "spill")
+
+	spill, err := OpenSpill(path)
+	require.NoError(t, err)
+
+	sessions := NewSessionManager()
+	sessions.SetMaxSessions(1, spill)
+
+	// "a" is only in the spill
+	sessions.CreateSessionWith("a")
+	sessions.CreateSessionWith("b")
+
+	deleted, err := sessions.Delete("a")
+	require.NoError(t, err)
+	assert.True(t, deleted)
+
+	_, exists := sessions.GetSession("a")
+	assert.False(t, exists)
+
+	// the tombstone outlives a restart
+	require.NoError(t, spill.Close())
+
+	spill, err = OpenSpill(path)
+	require.NoError(t, err)
+	defer spill.Close()
+
+	_, found, err := spill.Read("a")
+	require.NoError(t, err)
+	assert.False(t, found)
+}
+
+func TestSpillCompacts(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "spill")
+
+	spill, err := OpenSpill(path)
+	require.NoError(t, err)
+
+	for i := 0; i < 3*compactAfter; i++ {
+		session := Session{Id: "a", Deck: game.NewDeck()}
+		_, err := session.Deck.DealAt(i % 52)
+		require.NoError(t, err)
+		require.NoError(t, spill.Write(session))
+	}
+
+	require.NoError(t, spill.Write(Session{Id: "b", Deck: game.NewDeck()}))
+
+	// the superseded records are dropped once they outnumber the live ones
+	assert.Less(t, spill.stale, compactAfter)
+
+	session, found, err := spill.Read("a")
+	require.NoError(t, err)
+	require.True(t, found)
+	assert.Len(t, session.Deck.Cards, 51)
+	assert.NotContains(t, session.Deck.Cards, game.NewDeck().Cards[(3*compactAfter-1)%52])
+
+	// the file is compacted on opening, leaving the last records only
+	require.NoError(t, spill.Write(Session{Id: "b", Deck: game.NewDeck()}))
+	require.NoError(t, spill.Close())
+
+	spill, err = OpenSpill(path)
+	require.NoError(t, err)
+	defer spill.Close()
+
+	info, err := os.Stat(path)
+	require.NoError(t, err)
+	assert.Equal(t, spill.live(), info.Size())
+	assert.Len(t, spill.index, 2)
+
+	_, found, err = spill.Read("b")
+	require.NoError(t, err)
+	assert.True(t, found)
+}
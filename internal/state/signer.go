@@ -0,0 +1,176 @@
+package state
+
+import (
+	"bufio"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/hashicorp/go-multierror"
+)
+
+const (
+	// MinKeyLength guards against the keys that are short enough to be guessed
+	MinKeyLength = 16
+
+	// signatureLength is the number of the hmac bytes appended to the session ids
+	signatureLength = 16
+
+	signatureSeparator = "."
+)
+
+// Signer appends an hmac signature to the session ids so that the clients cannot choose their own ids;
+// the first key signs the new ids, while the rest are still accepted so that the keys could be rotated
+type Signer struct {
+	keys [][]byte
+}
+
+// NewSigner creates a signer with the given keys, the first of which is the current one
+func NewSigner(keys ...[]byte) (*Signer, error) {
+	if len(keys) == 0 {
+		return nil, errors.New("at least one session key is required")
+	}
+
+	for i, key := range keys {
+		if len(key) < MinKeyLength {
+			return nil, fmt.Errorf("session key #%d is shorter than %d bytes", i+1, MinKeyLength)
+		}
+	}
+
+	return &Signer{keys: keys}, nil
+}
+
+// NewEphemeralSigner creates a signer with a random key, so the signed ids do not outlive the process
+func NewEphemeralSigner() *Signer {
+	key := make([]byte, 32)
+
+	if _, err := io.ReadFull(rand.Reader, key); err != nil {
+		panic(err)
+	}
+
+	return &Signer{keys: [][]byte{key}}
+}
+
+// ReadKeys reads one key per line from the given file, skipping the blank lines & the '#' comments
+func ReadKeys(path string) (_ [][]byte, errs error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("could not open %q: %w", path, err)
+	}
+	defer func() {
+		if err := f.Close(); err != nil {
+			errs = multierror.Append(errs, fmt.Errorf("could not close %q: %w", path, err))
+		}
+	}()
+
+	var keys [][]byte
+
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+
+		keys = append(keys, []byte(line))
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("could not read %q: %w", path, err)
+	}
+
+	return keys, nil
+}
+
+// LoadOrCreateKeys reads the keys from the first of the files that exists, or else generates a random key; the keys
+// are then written to the files that do not exist yet, so that the same keys are found on the next startup
+func LoadOrCreateKeys(paths ...string) ([][]byte, error) {
+	var keys [][]byte
+
+	for _, path := range paths {
+		if _, err := os.Stat(path); err != nil {
+			continue
+		}
+
+		var err error
+		if keys, err = ReadKeys(path); err != nil {
+			return nil, err
+		}
+		if len(keys) == 0 {
+			return nil, fmt.Errorf("%q has no session keys", path)
+		}
+
+		break
+	}
+
+	if len(keys) == 0 {
+		key := make([]byte, 32)
+		if _, err := io.ReadFull(rand.Reader, key); err != nil {
+			return nil, err
+		}
+
+		keys = [][]byte{[]byte(base64.StdEncoding.EncodeToString(key))}
+	}
+
+	var b strings.Builder
+	b.WriteString("# the session keys, the first of which signs the new ids\n")
+	for _, key := range keys {
+		b.Write(key)
+		b.WriteByte('\n')
+	}
+
+	for _, path := range paths {
+		f, err := os.OpenFile(path, os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0o600)
+		if errors.Is(err, os.ErrExist) {
+			continue
+		} else if err != nil {
+			return nil, fmt.Errorf("could not create %q: %w", path, err)
+		}
+
+		_, err = f.WriteString(b.String())
+		if closeErr := f.Close(); err == nil {
+			err = closeErr
+		}
+		if err != nil {
+			return nil, fmt.Errorf("could not write %q: %w", path, err)
+		}
+	}
+
+	return keys, nil
+}
+
+// Sign appends the signature of the current key to the id
+func (s *Signer) Sign(id string) string {
+	return id + signatureSeparator + s.signature(s.keys[0], id)
+}
+
+// Verify checks that the signed id was signed with any of the keys
+func (s *Signer) Verify(signed string) bool {
+	i := strings.LastIndex(signed, signatureSeparator)
+	if i < 0 {
+		return false
+	}
+
+	id, signature := signed[:i], signed[i+len(signatureSeparator):]
+
+	for _, key := range s.keys {
+		if hmac.Equal([]byte(signature), []byte(s.signature(key, id))) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (s *Signer) signature(key []byte, id string) string {
+	mac := hmac.New(sha256.New, key)
+	mac.Write([]byte(id))
+
+	return base64.RawURLEncoding.EncodeToString(mac.Sum(nil)[:signatureLength])
+}
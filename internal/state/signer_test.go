@@ -0,0 +1,127 @@
+package state
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	oldKey = []byte("0123456789abcdef-old")
+	newKey = []byte("0123456789abcdef-new")
+)
+
+func TestSignerVerify(t *testing.T) {
+	signer, err := NewSigner(oldKey)
+	require.NoError(t, err)
+
+	signed := signer.Sign("abc")
+	assert.True(t, strings.HasPrefix(signed, "abc."))
+	assert.True(t, signer.Verify(signed))
+
+	failureCases := []string{
+		"",
+		"abc",
+		"abc.",
+		"abd" + strings.TrimPrefix(signed, "abc"),
+		signed + "x",
+	}
+
+	for _, c := range failureCases {
+		assert.False(t, signer.Verify(c), c)
+	}
+}
+
+func TestSignerRotation(t *testing.T) {
+	old, err := NewSigner(oldKey)
+	require.NoError(t, err)
+
+	rotated, err := NewSigner(newKey, oldKey)
+	require.NoError(t, err)
+
+	retired, err := NewSigner(newKey)
+	require.NoError(t, err)
+
+	// the ids signed with the old key are accepted until the key is retired
+	signedWithOld := old.Sign("abc")
+	assert.True(t, rotated.Verify(signedWithOld))
+	assert.False(t, retired.Verify(signedWithOld))
+
+	// the new ids are signed with the new key
+	assert.True(t, retired.Verify(rotated.Sign("abc")))
+}
+
+func TestNewSignerRejectsShortKeys(t *testing.T) {
+	_, err := NewSigner()
+	assert.Error(t, err)
+
+	_, err = NewSigner(newKey, []byte("short"))
+	assert.Error(t, err)
+}
+
+func TestReadKeys(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "keys")
+	require.NoError(t, os.WriteFile(path, []byte("# the current key goes first\n"+string(newKey)+"\n\n  "+string(oldKey)+"  \n"), 0o600))
+
+	keys, err := ReadKeys(path)
+	require.NoError(t, err)
+	assert.Equal(t, [][]byte{newKey, oldKey}, keys)
+}
+
+func TestSessionManagerRejectsUnsignedIds(t *testing.T) {
+	signer, err := NewSigner(newKey)
+	require.NoError(t, err)
+
+	sessions := NewSessionManager()
+	sessions.CreateSessionWith("restored")
+	sessions.SetSigner(signer, false)
+
+	created := sessions.CreateSession()
+	assert.True(t, signer.Verify(created.Id))
+
+	_, exists := sessions.GetSession(created.Id)
+	assert.True(t, exists)
+
+	_, exists = sessions.GetSession("restored")
+	assert.False(t, exists)
+
+	// the existing sessions are reachable in the compatibility mode, but the unknown ids are still not adopted
+	sessions.SetSigner(signer, true)
+
+	_, exists = sessions.GetSession("restored")
+	assert.True(t, exists)
+
+	_, exists = sessions.GetSession("unknown")
+	assert.False(t, exists)
+}
+
+func TestLoadOrCreateKeys(t *testing.T) {
+	dir := t.TempDir()
+	restore, persist := filepath.Join(dir, "restore.key"), filepath.Join(dir, "persist.key")
+
+	keys, err := LoadOrCreateKeys(restore, persist)
+	require.NoError(t, err)
+	require.Len(t, keys, 1)
+
+	_, err = NewSigner(keys...)
+	require.NoError(t, err)
+
+	// the generated key is found on the next startup, whichever of the files is left
+	require.NoError(t, os.Remove(restore))
+
+	again, err := LoadOrCreateKeys(restore, persist)
+	require.NoError(t, err)
+	assert.Equal(t, keys, again)
+
+	written, err := ReadKeys(restore)
+	require.NoError(t, err)
+	assert.Equal(t, keys, written)
+
+	require.NoError(t, os.WriteFile(persist, []byte("# no keys\n"), 0o600))
+	_, err = LoadOrCreateKeys(persist)
+	assert.Error(t, err)
+}
@@ -0,0 +1,265 @@
+package state
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/hashicorp/go-multierror"
+)
+
+const (
+	// tombstone replaces the deck in the records of the deleted sessions
+	tombstone = "-"
+
+	// compactAfter is the number of the stale records the spill may hold before it is compacted (as long as there
+	// are more of them than the live records)
+	compactAfter = 1024
+)
+
+// Spill is an append-only file of the sessions evicted from memory (in the same format as the persisted sessions);
+// a session may be spilled multiple times, in which case its last record is the current one. The records are
+// indexed in memory, so that reading a session does not scan the file, & the file is compacted on opening & once
+// the stale records outnumber the live ones.
+type Spill struct {
+	path string
+	file *os.File
+
+	// index holds the last record of every spilled session (but the deleted ones)
+	index map[string]spillRecord
+
+	// size is the length of the file, i.e. the offset of the next record
+	size int64
+
+	// stale counts the records superseded by the later ones or by the tombstones
+	stale int
+}
+
+// spillRecord is the position of a line in the spill file, including the line feed
+type spillRecord struct {
+	offset int64
+	length int
+}
+
+// OpenSpill opens (or creates) the spill file, keeping the sessions spilled before a restart
+func OpenSpill(path string) (*Spill, error) {
+	f, err := os.OpenFile(path, os.O_RDWR|os.O_CREATE|os.O_APPEND, 0o600)
+	if err != nil {
+		return nil, fmt.Errorf("could not open %q: %w", path, err)
+	}
+
+	s := &Spill{
+		path:  path,
+		file:  f,
+		index: make(map[string]spillRecord),
+	}
+
+	if err := s.load(); err != nil {
+		return nil, multierror.Append(err, s.Close())
+	}
+
+	if err := s.compact(); err != nil {
+		return nil, multierror.Append(err, s.Close())
+	}
+
+	return s, nil
+}
+
+// Write appends the session to the spill
+func (s *Spill) Write(session Session) error {
+	line := formatSession(session)
+
+	if _, err := io.WriteString(s.file, line); err != nil {
+		return fmt.Errorf("could not write to %q: %w", s.path, err)
+	}
+
+	s.record(line)
+	s.compactIfStale()
+
+	return nil
+}
+
+// Delete appends a tombstone if the session has been spilled, so that its earlier records are not brought back
+func (s *Spill) Delete(id string) (found bool, _ error) {
+	if _, found := s.index[id]; !found {
+		return false, nil
+	}
+
+	line := id + " " + tombstone + "\n"
+
+	if _, err := io.WriteString(s.file, line); err != nil {
+		return true, fmt.Errorf("could not write to %q: %w", s.path, err)
+	}
+
+	s.record(line)
+	s.compactIfStale()
+
+	return true, nil
+}
+
+// Read reads the last record of the given session
+func (s *Spill) Read(id string) (_ Session, found bool, _ error) {
+	r, found := s.index[id]
+	if !found {
+		return Session{}, false, nil
+	}
+
+	line, err := s.readRecord(r)
+	if err != nil {
+		return Session{}, false, err
+	}
+
+	session, err := parseSession(strings.TrimSuffix(line, "\n"))
+	if err != nil {
+		return Session{}, false, fmt.Errorf("%q: %w", s.path, err)
+	}
+
+	return session, true, nil
+}
+
+// Close closes the spill file
+func (s *Spill) Close() (errs error) {
+	if err := s.file.Close(); err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("could not close %q: %w", s.path, err))
+	}
+
+	return errs
+}
+
+// load indexes the records of the file; an incomplete last line (e.g. after a crash) is skipped
+func (s *Spill) load() error {
+	reader := bufio.NewReader(s.file)
+
+	for {
+		line, err := reader.ReadString('\n')
+		if strings.HasSuffix(line, "\n") {
+			s.record(line)
+		} else {
+			s.size += int64(len(line))
+		}
+
+		if errors.Is(err, io.EOF) {
+			return nil
+		} else if err != nil {
+			return fmt.Errorf("could not read %q: %w", s.path, err)
+		}
+	}
+}
+
+// record indexes the line appended at the end of the file
+func (s *Spill) record(line string) {
+	id, deck, _ := strings.Cut(line, " ")
+
+	if _, exists := s.index[id]; exists {
+		s.stale++
+	}
+
+	if deck == tombstone+"\n" {
+		delete(s.index, id)
+		s.stale++
+	} else {
+		s.index[id] = spillRecord{offset: s.size, length: len(line)}
+	}
+
+	s.size += int64(len(line))
+}
+
+func (s *Spill) readRecord(r spillRecord) (string, error) {
+	b := make([]byte, r.length)
+
+	if _, err := s.file.ReadAt(b, r.offset); err != nil {
+		return "", fmt.Errorf("could not read %q: %w", s.path, err)
+	}
+
+	return string(b), nil
+}
+
+func (s *Spill) compactIfStale() {
+	if s.stale < compactAfter || s.stale < len(s.index) {
+		return
+	}
+
+	if err := s.compact(); err != nil {
+		slog.Warn("could not compact the spilled sessions", "error", err)
+	}
+}
+
+// compact rewrites the file with the last records of the sessions only, replacing it atomically
+func (s *Spill) compact() (errs error) {
+	if s.stale == 0 && s.size == s.live() {
+		return nil
+	}
+
+	tmp, err := os.CreateTemp(filepath.Dir(s.path), filepath.Base(s.path)+".*")
+	if err != nil {
+		return fmt.Errorf("could not compact %q: %w", s.path, err)
+	}
+
+	renamed := false
+	defer func() {
+		if errs != nil && !renamed {
+			_ = tmp.Close()
+			_ = os.Remove(tmp.Name())
+		}
+	}()
+
+	var (
+		index  = make(map[string]spillRecord, len(s.index))
+		size   int64
+		writer = bufio.NewWriter(tmp)
+	)
+
+	for id, r := range s.index {
+		line, err := s.readRecord(r)
+		if err != nil {
+			return err
+		}
+
+		if _, err := writer.WriteString(line); err != nil {
+			return fmt.Errorf("could not compact %q: %w", s.path, err)
+		}
+
+		index[id] = spillRecord{offset: size, length: r.length}
+		size += int64(r.length)
+	}
+
+	if err := writer.Flush(); err != nil {
+		return fmt.Errorf("could not compact %q: %w", s.path, err)
+	}
+	if err := tmp.Close(); err != nil {
+		return fmt.Errorf("could not compact %q: %w", s.path, err)
+	}
+	if err := os.Rename(tmp.Name(), s.path); err != nil {
+		return fmt.Errorf("could not compact %q: %w", s.path, err)
+	}
+
+	renamed = true
+
+	f, err := os.OpenFile(s.path, os.O_RDWR|os.O_APPEND, 0o600)
+	if err != nil {
+		return fmt.Errorf("could not open %q: %w", s.path, err)
+	}
+
+	if err := s.file.Close(); err != nil {
+		slog.Warn("could not close the spill file replaced by the compacted one", "error", err)
+	}
+
+	s.file, s.index, s.size, s.stale = f, index, size, 0
+
+	return nil
+}
+
+// live returns the total length of the last records of the sessions
+func (s *Spill) live() int64 {
+	var size int64
+	for _, r := range s.index {
+		size += int64(r.length)
+	}
+
+	return size
+}
@@ -0,0 +1,245 @@
+package main
+
+import (
+	"net/http"
+
+	"github.com/AntonAverchenkov/cards-http-service/internal/api"
+	"github.com/AntonAverchenkov/cards-http-service/internal/game"
+	"github.com/AntonAverchenkov/cards-http-service/internal/game/klondike"
+	"github.com/AntonAverchenkov/cards-http-service/internal/state"
+	"github.com/labstack/echo/v4"
+)
+
+// (GET /games/klondike) : get the state of the klondike solitaire
+func (h *handlers) KlondikeShow(ctx echo.Context) error {
+	h.lock.Lock()
+	defer h.lock.Unlock()
+
+	session, err := h.fetchSessionSetCookie(ctx)
+	if err != nil {
+		return Problem(ctx, err)
+	}
+
+	g, err := klondikeGame(session)
+	if err != nil {
+		return Problem(ctx, err)
+	}
+
+	return JSON(ctx, http.StatusOK, fromKlondikeGame(g))
+}
+
+// (POST /games/klondike?draw={draw}) : start a klondike solitaire by dealing the deck's 52 cards into the tableau & the stock
+func (h *handlers) KlondikeStart(ctx echo.Context, params api.KlondikeStartParams) error {
+	draw := 1
+	if params.Draw != nil {
+		draw = int(*params.Draw)
+	}
+
+	h.lock.Lock()
+	defer h.lock.Unlock()
+
+	session, err := h.fetchMutableSession(ctx)
+	if err != nil {
+		return Problem(ctx, err)
+	}
+
+	g, err := klondike.New(session.Deck.Cards, draw)
+	if err != nil {
+		return Problem(ctx, err)
+	}
+
+	// the cards stay out of the deck until the game is ended
+	session.Deck.Cards = nil
+	h.sessions.SetGame(session.Id, g)
+
+	return JSON(ctx, http.StatusCreated, fromKlondikeGame(g))
+}
+
+// (GET /games/klondike/moves) : list the legal moves of the klondike solitaire
+func (h *handlers) KlondikeMoves(ctx echo.Context) error {
+	h.lock.Lock()
+	defer h.lock.Unlock()
+
+	session, err := h.fetchSessionSetCookie(ctx)
+	if err != nil {
+		return Problem(ctx, err)
+	}
+
+	g, err := klondikeGame(session)
+	if err != nil {
+		return Problem(ctx, err)
+	}
+
+	moves := g.LegalMoves()
+
+	result := make([]api.KlondikeMove, 0, len(moves))
+	for _, m := range moves {
+		result = append(result, fromKlondikeMove(m))
+	}
+
+	return JSON(ctx, http.StatusOK, result)
+}
+
+// (POST /games/klondike/moves) : make the move specified in body in the klondike solitaire
+func (h *handlers) KlondikeMove(ctx echo.Context) error {
+	var body api.KlondikeMove
+	if err := ctx.Bind(&body); err != nil {
+		return Problem(ctx, err)
+	}
+
+	m, err := toKlondikeMove(body)
+	if err != nil {
+		return Problem(ctx, err)
+	}
+
+	h.lock.Lock()
+	defer h.lock.Unlock()
+
+	session, err := h.fetchPlayingSession(ctx)
+	if err != nil {
+		return Problem(ctx, err)
+	}
+
+	g, err := klondikeGame(session)
+	if err != nil {
+		return Problem(ctx, err)
+	}
+
+	if err := g.Apply(m); err != nil {
+		return Problem(ctx, err)
+	}
+
+	return JSON(ctx, http.StatusOK, fromKlondikeGame(g))
+}
+
+// (GET /games/klondike/hint) : search for a way to win the klondike solitaire
+func (h *handlers) KlondikeHint(ctx echo.Context) error {
+	h.lock.Lock()
+	defer h.lock.Unlock()
+
+	// the search is costly, so it is charged like a move
+	session, err := h.fetchPlayingSession(ctx)
+	if err != nil {
+		return Problem(ctx, err)
+	}
+
+	g, err := klondikeGame(session)
+	if err != nil {
+		return Problem(ctx, err)
+	}
+
+	if g.Status() != klondike.StatusInProgress {
+		return Problem(ctx, klondike.ErrGameOver)
+	}
+
+	hint := g.Hint(klondike.HintLimit)
+
+	result := api.KlondikeHint{
+		Status:   api.KlondikeHintStatusUnknown,
+		Explored: hint.Explored,
+	}
+
+	if len(hint.Solution) != 0 {
+		move, moves := fromKlondikeMove(hint.Solution[0]), len(hint.Solution)
+
+		result.Status = api.KlondikeHintStatusSolvable
+		result.Move = &move
+		result.Moves = &moves
+	}
+
+	return JSON(ctx, http.StatusOK, result)
+}
+
+// (DELETE /games/klondike) : end the klondike solitaire & put the cards back into the deck
+func (h *handlers) KlondikeEnd(ctx echo.Context) error {
+	format, err := negotiate(ctx)
+	if err != nil {
+		return Problem(ctx, err)
+	}
+
+	h.lock.Lock()
+	defer h.lock.Unlock()
+
+	session, err := h.fetchPlayingSession(ctx)
+	if err != nil {
+		return Problem(ctx, err)
+	}
+
+	g, err := klondikeGame(session)
+	if err != nil {
+		return Problem(ctx, err)
+	}
+
+	session.Deck.Cards = append(session.Deck.Cards, g.Cards()...)
+	h.sessions.SetGame(session.Id, nil)
+
+	return Cards(ctx, http.StatusOK, format, session.Deck.Cards)
+}
+
+// klondikeGame returns the klondike solitaire being played in the session
+func klondikeGame(session state.Session) (*klondike.Game, error) {
+	g, ok := session.Game.(*klondike.Game)
+	if !ok {
+		return nil, state.ErrGameNotFound
+	}
+
+	return g, nil
+}
+
+func fromKlondikeGame(g *klondike.Game) api.KlondikeGame {
+	result := api.KlondikeGame{
+		Status:      api.KlondikeGameStatus(g.Status().String()),
+		Draw:        g.Draw,
+		Moves:       g.Moves,
+		Stock:       len(g.Stock),
+		Waste:       fromGameCards(g.Waste),
+		Foundations: api.KlondikeGame_Foundations{AdditionalProperties: make(map[string]int, len(g.Foundations))},
+		Tableau:     make([]api.KlondikeColumn, 0, len(g.Tableau)),
+	}
+
+	for suit, foundation := range g.Foundations {
+		result.Foundations.AdditionalProperties[game.Suit(suit).String()] = len(foundation)
+	}
+
+	// only the face-up cards of the columns are shown
+	for _, column := range g.Tableau {
+		result.Tableau = append(result.Tableau, api.KlondikeColumn{
+			Hidden: column.Hidden,
+			Cards:  fromGameCards(column.Cards[column.Hidden:]),
+		})
+	}
+
+	return result
+}
+
+func fromKlondikeMove(m klondike.Move) api.KlondikeMove {
+	result := api.KlondikeMove{
+		From: api.KlondikePile(m.From.String()),
+		To:   api.KlondikePile(m.To.String()),
+	}
+
+	if m.Count > 1 {
+		result.Count = &m.Count
+	}
+
+	return result
+}
+
+func toKlondikeMove(m api.KlondikeMove) (klondike.Move, error) {
+	from, err := klondike.ParsePile(string(m.From))
+	if err != nil {
+		return klondike.Move{}, err
+	}
+
+	to, err := klondike.ParsePile(string(m.To))
+	if err != nil {
+		return klondike.Move{}, err
+	}
+
+	count := 1
+	if m.Count != nil {
+		count = *m.Count
+	}
+
+	return klondike.Move{From: from, To: to, Count: count}, nil
+}
@@ -0,0 +1,125 @@
+package main
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"log/slog"
+	"time"
+
+	"github.com/AntonAverchenkov/cards-http-service/internal/game"
+	"github.com/labstack/echo/v4"
+)
+
+const (
+	requestIDHeader = "X-Request-Id"
+
+	// requestIDMaxLength guards the logs against the oversized ids sent by the clients
+	requestIDMaxLength = 128
+)
+
+// The keys under which the handlers leave the details of the request for the logging middleware
+const (
+	requestIDKey = "request_id"
+	sessionKey   = "session"
+	cardKey      = "card"
+)
+
+// newLogger creates a logger that writes the records in the given format ("text" or "json") at or above the given level
+func newLogger(w io.Writer, format string, level string) (*slog.Logger, error) {
+	var l slog.Level
+	if err := l.UnmarshalText([]byte(level)); err != nil {
+		return nil, fmt.Errorf("could not parse log level %q: %w", level, err)
+	}
+
+	opts := &slog.HandlerOptions{Level: l}
+
+	switch format {
+	case "text":
+		return slog.New(slog.NewTextHandler(w, opts)), nil
+	case "json":
+		return slog.New(slog.NewJSONHandler(w, opts)), nil
+	default:
+		return nil, fmt.Errorf("unknown log format %q", format)
+	}
+}
+
+// requestIDMiddleware propagates the client's X-Request-Id or assigns a new one
+func requestIDMiddleware(next echo.HandlerFunc) echo.HandlerFunc {
+	return func(ctx echo.Context) error {
+		id := ctx.Request().Header.Get(requestIDHeader)
+		if id == "" || len(id) > requestIDMaxLength {
+			id = generateRequestID()
+		}
+
+		ctx.Set(requestIDKey, id)
+		ctx.Response().Header().Set(requestIDHeader, id)
+
+		return next(ctx)
+	}
+}
+
+// loggingMiddleware logs every request along with its operation id, session & the card it affected
+func loggingMiddleware(logger *slog.Logger, operation func(ctx echo.Context) string) echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(ctx echo.Context) error {
+			start := time.Now()
+
+			// let the error handler write the response, so that its status code is known
+			if err := next(ctx); err != nil {
+				ctx.Error(err)
+			}
+
+			attrs := []slog.Attr{
+				slog.String("request_id", requestID(ctx)),
+				slog.String("method", ctx.Request().Method),
+				slog.String("uri", ctx.Request().RequestURI),
+				slog.String("operation", operation(ctx)),
+				slog.Int("status", ctx.Response().Status),
+				slog.Duration("latency", time.Since(start)),
+			}
+
+			// the session ids are credentials, so only their hashes are logged
+			if id, ok := ctx.Get(sessionKey).(string); ok {
+				attrs = append(attrs, slog.String("session", hashSessionID(id)))
+			}
+
+			if card, ok := ctx.Get(cardKey).(game.Card); ok {
+				attrs = append(attrs, slog.String("card", card.ShortString()))
+			}
+
+			level := slog.LevelInfo
+			if ctx.Response().Status >= 500 {
+				level = slog.LevelError
+			}
+
+			logger.LogAttrs(ctx.Request().Context(), level, "request", attrs...)
+
+			return nil
+		}
+	}
+}
+
+// requestID returns the id assigned to the request by the requestIDMiddleware
+func requestID(ctx echo.Context) string {
+	id, _ := ctx.Get(requestIDKey).(string)
+	return id
+}
+
+// hashSessionID shortens & obscures the session id while keeping the requests of a session correlated in the logs
+func hashSessionID(id string) string {
+	sum := sha256.Sum256([]byte(id))
+	return hex.EncodeToString(sum[:6])
+}
+
+func generateRequestID() string {
+	b := make([]byte, 16)
+
+	if _, err := io.ReadFull(rand.Reader, b); err != nil {
+		panic(err)
+	}
+
+	return hex.EncodeToString(b)
+}
@@ -1,25 +1,62 @@
 package main
 
 import (
+	"context"
+	"errors"
 	"fmt"
+	"io"
+	"io/ioutil"
 	"log"
+	"log/slog"
+	"net"
+	"net/http"
 	"os"
 	"os/signal"
 	"sync"
 	"syscall"
+	"time"
 
+	"github.com/AntonAverchenkov/cards-http-service/cardspb"
 	"github.com/AntonAverchenkov/cards-http-service/internal/api"
+	"github.com/AntonAverchenkov/cards-http-service/internal/limit"
+	"github.com/AntonAverchenkov/cards-http-service/internal/metrics"
+	"github.com/AntonAverchenkov/cards-http-service/internal/rpc"
 	"github.com/AntonAverchenkov/cards-http-service/internal/state"
 	"github.com/deepmap/oapi-codegen/pkg/middleware"
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/getkin/kin-openapi/openapi3filter"
 	"github.com/hashicorp/go-multierror"
 	"github.com/jessevdk/go-flags"
 	"github.com/labstack/echo/v4"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
 )
 
 type CommandLineOptions struct {
-	Address             string `long:"address"                env:"ADDRESS"                description:"Listen to http traffic on this tcp address"      default:"localhost:8080"`
-	SessionsPersistTo   string `long:"sessions-persist-to"    env:"SESSIONS_PERSIST_TO"    description:"Persist the sessions to this file on exit"       default:""`
-	SessionsRestoreFrom string `long:"sessions-restore-from"  env:"SESSIONS_RESTORE_FROM"  description:"Restore the sessions from this file on startup"  default:""`
+	Address              string        `long:"address"                env:"ADDRESS"                description:"Listen to http traffic on this tcp address"                                                  default:"localhost:8080"`
+	GrpcAddress          string        `long:"grpc-address"           env:"GRPC_ADDRESS"           description:"Listen to grpc traffic on this tcp address"                                                  default:""`
+	ShutdownTimeout      time.Duration `long:"shutdown-timeout"       env:"SHUTDOWN_TIMEOUT"       description:"Wait this long for the in-flight requests to finish on shutdown"                             default:"10s"`
+	SessionsPersistTo    string        `long:"sessions-persist-to"    env:"SESSIONS_PERSIST_TO"    description:"Persist the sessions to this file on exit"                                                   default:""`
+	SessionsPersistEvery time.Duration `long:"sessions-persist-every" env:"SESSIONS_PERSIST_EVERY" description:"Also persist the sessions this often while serving (0 persists them on exit only)"            default:"1m"`
+	SessionsRestoreFrom  string        `long:"sessions-restore-from"  env:"SESSIONS_RESTORE_FROM"  description:"Restore the sessions from this file on startup"                                              default:""`
+	AdminToken           string        `long:"admin-token"            env:"ADMIN_TOKEN"            description:"Enable the /admin api for the requests bearing this token"                                   default:""`
+	SessionKeysFile      string        `long:"session-keys-file"      env:"SESSION_KEYS_FILE"      description:"Sign the session ids with the keys in this file, one per line (the first signs the new ids)" default:""`
+	SessionKeys          []string      `long:"session-key"            env:"SESSION_KEYS"           description:"Sign the session ids with this key; repeat to rotate (the first signs the new ids)"                                   env-delim:","`
+	SessionAdoptUnsigned bool          `long:"session-adopt-unsigned" env:"SESSION_ADOPT_UNSIGNED" description:"Accept the unsigned ids of the existing (e.g. restored) sessions"`
+	MaxSessions          int           `long:"max-sessions"           env:"MAX_SESSIONS"           description:"Evict the least recently used sessions over this many (0 disables the cap)"                  default:"100000"`
+	SessionsSpillTo      string        `long:"sessions-spill-to"      env:"SESSIONS_SPILL_TO"      description:"Append the evicted sessions to this file & bring them back from it when used"                default:""`
+	SessionCreateRate    float64       `long:"session-create-rate"    env:"SESSION_CREATE_RATE"    description:"Sessions created per second per client address (0 disables the limit)"                       default:"1"`
+	SessionCreateBurst   int           `long:"session-create-burst"   env:"SESSION_CREATE_BURST"   description:"Sessions created at once per client address"                                                 default:"20"`
+	MutationRate         float64       `long:"mutation-rate"          env:"MUTATION_RATE"          description:"Deck changes per second per session (0 disables the limit)"                                  default:"20"`
+	MutationBurst        int           `long:"mutation-burst"         env:"MUTATION_BURST"         description:"Deck changes at once per session"                                                            default:"100"`
+	ClientMutationRate   float64       `long:"client-mutation-rate"   env:"CLIENT_MUTATION_RATE"   description:"Deck changes per second per client address (0 disables the limit)"                           default:"100"`
+	ClientMutationBurst  int           `long:"client-mutation-burst"  env:"CLIENT_MUTATION_BURST"  description:"Deck changes at once per client address"                                                     default:"200"`
+	TLSCert              string        `long:"tls-cert"               env:"TLS_CERT"               description:"Serve https with this pem certificate file (reloaded on SIGHUP)"                             default:""`
+	TLSKey               string        `long:"tls-key"                env:"TLS_KEY"                description:"Serve https with this pem private key file (reloaded on SIGHUP)"                             default:""`
+	TLSSelfSigned        bool          `long:"tls-self-signed"        env:"TLS_SELF_SIGNED"        description:"Serve https with a generated localhost certificate (development only)"`
+	LogFormat            string        `long:"log-format"             env:"LOG_FORMAT"             description:"Write the logs in this format"                                                               default:"text"                         choice:"text" choice:"json"`
+	LogLevel             string        `long:"log-level"              env:"LOG_LEVEL"              description:"Only write the logs at or above this level"                                                  default:"info"                         choice:"debug" choice:"info" choice:"warn" choice:"error"`
 }
 
 func main() {
@@ -33,20 +70,38 @@ func main() {
 		log.Fatalf("Error :: command-line argument parsing failed: %v\n", err)
 	}
 
-	if err := run(cl); err != nil {
+	logger, err := newLogger(os.Stderr, cl.LogFormat, cl.LogLevel)
+	if err != nil {
 		log.Fatalf("Error :: %v\n", err)
 	}
+
+	slog.SetDefault(logger)
+
+	if err := run(cl, logger); err != nil {
+		logger.Error("cards-http-service failed", "error", err)
+		os.Exit(1)
+	}
 }
 
-func run(cl CommandLineOptions) (errs error) {
-	/* */ log.Println("run(): cards-http-service begin")
-	defer log.Println("run(): cards-http-service end")
+func run(cl CommandLineOptions, logger *slog.Logger) (errs error) {
+	/* */ logger.Info("cards-http-service begin")
+	defer logger.Info("cards-http-service end")
+
+	cert, err := newCertificate(cl)
+	if err != nil {
+		return err
+	}
 
 	swagger, err := api.GetSwagger()
 	if err != nil {
 		return fmt.Errorf("could not load swagger spec: %w", err)
 	}
 
+	limits, err := newLimits(cl)
+	if err != nil {
+		return err
+	}
+
 	var (
 		lock     sync.Mutex
 		sessions *state.SessionManager
@@ -54,62 +109,365 @@ func run(cl CommandLineOptions) (errs error) {
 
 	// restore the sessions
 	if cl.SessionsRestoreFrom != "" {
-		log.Printf("run(): restoring sessions from %q\n", cl.SessionsRestoreFrom)
+		logger.Info("restoring sessions", "path", cl.SessionsRestoreFrom)
 
 		sessions, err = state.Restore(cl.SessionsRestoreFrom)
 		if err != nil {
-			log.Printf("run(): could not restore sessions; starting new ones: %v\n", err)
+			logger.Warn("could not restore sessions; starting new ones", "error", err)
 			sessions = state.NewSessionManager()
 		}
 	} else {
 		sessions = state.NewSessionManager()
 	}
 
+	// sign the session ids so that the clients could not choose their own
+	signer, err := newSigner(cl, logger)
+	if err != nil {
+		return err
+	}
+
+	sessions.SetSigner(signer, cl.SessionAdoptUnsigned)
+
+	// cap the number of sessions in memory, optionally spilling the evicted ones to the disk
+	var spill *state.Spill
+
+	if cl.SessionsSpillTo != "" {
+		spill, err = state.OpenSpill(cl.SessionsSpillTo)
+		if err != nil {
+			return err
+		}
+		defer func() {
+			if err := spill.Close(); err != nil {
+				errs = multierror.Append(errs, err)
+			}
+		}()
+	}
+
+	sessions.SetMaxSessions(cl.MaxSessions, spill)
+
+	// the sessions are kept in memory unless they are persisted on exit
+	health := health{
+		store: api.VersionStoreMemory,
+	}
+	if cl.SessionsPersistTo != "" {
+		health.store = api.VersionStoreFile
+	}
+
 	handlers := handlers{
 		lock:     &lock,
 		sessions: sessions,
+		limits:   limits,
+		health:   &health,
 	}
 
+	// the validator middleware needs to read csv bodies in order to check them against the spec
+	openapi3filter.RegisterBodyDecoder("text/csv", textBodyDecoder)
+
+	metrics.RegisterSessionCount(func() int {
+		lock.Lock()
+		defer lock.Unlock()
+
+		return sessions.Len()
+	})
+
+	operation := operationResolver(swagger)
+
 	server := echo.New()
-	server.Use(middleware.OapiRequestValidator(swagger))
+	server.HideBanner = true
+	server.HidePort = true
+	server.IPExtractor = echo.ExtractIPDirect() // the forwarding headers could be forged to get around the rate limits
+	server.HTTPErrorHandler = problemErrorHandler
+	server.Use(requestIDMiddleware)
+	server.Use(loggingMiddleware(logger, operation))
+	server.Use(metricsMiddleware(operation))
+	server.Use(middleware.OapiRequestValidatorWithOptions(swagger, &middleware.Options{
+		Options: openapi3filter.Options{
+			AuthenticationFunc: adminAuthenticator(cl.AdminToken),
+		},
+		// the metrics endpoint is not a part of the api spec
+		Skipper: func(ctx echo.Context) bool {
+			return ctx.Path() == metricsPath
+		},
+	}))
 
 	api.RegisterHandlers(server, &handlers)
+	server.GET(metricsPath, echo.WrapHandler(promhttp.Handler()))
+
+	// the grpc api is served alongside the rest api on its own address
+	var grpcServer *grpc.Server
+
+	grpcDone := make(chan error, 1)
+
+	if cl.GrpcAddress != "" {
+		listener, err := net.Listen("tcp", cl.GrpcAddress)
+		if err != nil {
+			return fmt.Errorf("could not listen to grpc traffic on %q: %w", cl.GrpcAddress, err)
+		}
+
+		var opts []grpc.ServerOption
+		if cert != nil {
+			opts = append(opts, grpc.Creds(credentials.NewTLS(cert.TLSConfig())))
+		}
 
-	log.Printf("run(): starting to listen & serve on %q\n", cl.Address)
+		grpcServer = grpc.NewServer(opts...)
+		cardspb.RegisterDeckServer(grpcServer, rpc.NewServer(&lock, sessions, limits))
 
-	done := make(chan bool)
+		logger.Info("starting to serve grpc", "address", cl.GrpcAddress)
+
+		go func() {
+			grpcDone <- grpcServer.Serve(listener)
+		}()
+	}
+
+	logger.Info("starting to listen & serve", "address", cl.Address, "tls", cert != nil)
+
+	done := make(chan error, 1)
 	go func() {
-		server.Start(cl.Address)
-		close(done)
+		if cert == nil {
+			done <- server.Start(cl.Address)
+			return
+		}
+
+		server.TLSServer.Addr = cl.Address
+		server.TLSServer.TLSConfig = cert.TLSConfig()
+
+		done <- server.StartServer(server.TLSServer)
 	}()
 
+	// snapshot the sessions periodically, so that a crash loses the recent mutations only
+	stopSnapshots := make(chan struct{})
+	snapshotsStopped := make(chan struct{})
+
+	if cl.SessionsPersistTo != "" && cl.SessionsPersistEvery > 0 {
+		go func() {
+			defer close(snapshotsStopped)
+
+			ticker := time.NewTicker(cl.SessionsPersistEvery)
+			defer ticker.Stop()
+
+			for {
+				select {
+				case <-ticker.C:
+					if err := persistSessions(&lock, sessions, cl.SessionsPersistTo); err != nil {
+						logger.Error("could not snapshot the sessions", "error", err)
+					}
+				case <-stopSnapshots:
+					return
+				}
+			}
+		}()
+	} else {
+		close(snapshotsStopped)
+	}
+
+	// the sessions have been restored by now, so the traffic could be routed to this instance
+	health.ready.Store(true)
+
 	// block until an interrupt signal or a server shutdown
 	signalled := make(chan os.Signal, 1)
 	signal.Notify(
 		signalled,
-		syscall.SIGHUP,  // sent to the process when its controlling terminal is closed
 		syscall.SIGINT,  // sent to the process by its controlling terminal when a user wishes to interrupt the process
 		syscall.SIGTERM, // sent to the process to request its termination
 	)
 
-	select {
-	case <-signalled:
-		log.Println("run(): received a termination signal; exiting")
-	case <-done:
-		log.Println("run(): server has stopped; exiting")
+	// the certificate is reloaded rather than the process being terminated on a hangup
+	hangup := make(chan os.Signal, 1)
+	signal.Notify(hangup, syscall.SIGHUP)
+
+wait:
+	for {
+		select {
+		case <-hangup:
+			if cert == nil {
+				logger.Info("received a hangup signal; nothing to reload")
+				continue
+			}
+
+			if err := cert.Reload(); err != nil {
+				logger.Error("could not reload the certificate; keeping the previous one", "error", err)
+			} else {
+				logger.Info("reloaded the certificate", "path", cl.TLSCert)
+			}
+		case <-signalled:
+			logger.Info("received a termination signal; exiting")
+			break wait
+		case err := <-done:
+			logger.Error("server has stopped; exiting")
+			errs = multierror.Append(errs, fmt.Errorf("server has stopped: %w", err))
+			break wait
+		case err := <-grpcDone:
+			logger.Error("grpc server has stopped; exiting")
+			errs = multierror.Append(errs, fmt.Errorf("grpc server has stopped: %w", err))
+			break wait
+		}
 	}
 
-	// persist the sessions
-	if cl.SessionsPersistTo != "" {
-		lock.Lock()
-		defer lock.Unlock()
+	// stop accepting new connections & wait for the in-flight requests to drain
+	health.ready.Store(false)
 
-		log.Printf("run(): persisting sessions to %q\n", cl.SessionsPersistTo)
+	logger.Info("shutting down; waiting for the in-flight requests", "timeout", cl.ShutdownTimeout)
 
-		if err := sessions.Persist(cl.SessionsPersistTo); err != nil {
+	ctx, cancel := context.WithTimeout(context.Background(), cl.ShutdownTimeout)
+	defer cancel()
+
+	if err := server.Shutdown(ctx); err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("could not shut down gracefully: %w", err))
+	}
+
+	if grpcServer != nil {
+		if err := stopGracefully(ctx, grpcServer); err != nil {
+			errs = multierror.Append(errs, fmt.Errorf("could not shut down grpc gracefully: %w", err))
+		}
+	}
+
+	// persist the sessions, once the last snapshot (if any) is written
+	close(stopSnapshots)
+	<-snapshotsStopped
+
+	if cl.SessionsPersistTo != "" {
+		logger.Info("persisting sessions", "path", cl.SessionsPersistTo)
+
+		if err := persistSessions(&lock, sessions, cl.SessionsPersistTo); err != nil {
 			errs = multierror.Append(errs, fmt.Errorf("could not persist: %w", err))
 		}
 	}
 
+	return errs
+}
+
+// persistSessions writes the sessions to the file under the lock, observing the duration & the size of the snapshot
+func persistSessions(lock *sync.Mutex, sessions *state.SessionManager, path string) error {
+	lock.Lock()
+	defer lock.Unlock()
+
+	start := time.Now()
+
+	if err := sessions.Persist(path); err != nil {
+		return err
+	}
+
+	if info, err := os.Stat(path); err == nil {
+		metrics.ObservePersist(time.Since(start), info.Size())
+	}
+
 	return nil
 }
+
+// newLimits creates the rate limits, rejecting the empty bursts of the enabled limits (which would allow nothing)
+func newLimits(cl CommandLineOptions) (*limit.Limits, error) {
+	for _, l := range []struct {
+		rate, burst string
+		perSecond   float64
+		events      int
+	}{
+		{"--session-create-rate", "--session-create-burst", cl.SessionCreateRate, cl.SessionCreateBurst},
+		{"--mutation-rate", "--mutation-burst", cl.MutationRate, cl.MutationBurst},
+		{"--client-mutation-rate", "--client-mutation-burst", cl.ClientMutationRate, cl.ClientMutationBurst},
+	} {
+		if l.perSecond > 0 && l.events < 1 {
+			return nil, fmt.Errorf("%s must be at least 1 unless %s is 0", l.burst, l.rate)
+		}
+	}
+
+	return &limit.Limits{
+		SessionCreate:    limit.NewLimiter("session creation", cl.SessionCreateRate, cl.SessionCreateBurst),
+		Mutation:         limit.NewLimiter("mutation", cl.MutationRate, cl.MutationBurst),
+		MutationByClient: limit.NewLimiter("client mutation", cl.ClientMutationRate, cl.ClientMutationBurst),
+	}, nil
+}
+
+// newSigner loads the session keys from either the file or the flags; without any keys, the persisted sessions are
+// signed with a generated key kept alongside them (see sessionKeyFiles) & the others with an ephemeral key
+func newSigner(cl CommandLineOptions, logger *slog.Logger) (*state.Signer, error) {
+	var keys [][]byte
+
+	switch {
+	case cl.SessionKeysFile != "" && len(cl.SessionKeys) != 0:
+		return nil, errors.New("--session-keys-file cannot be combined with --session-key")
+	case cl.SessionKeysFile != "":
+		var err error
+		if keys, err = state.ReadKeys(cl.SessionKeysFile); err != nil {
+			return nil, err
+		}
+	default:
+		for _, key := range cl.SessionKeys {
+			keys = append(keys, []byte(key))
+		}
+	}
+
+	if len(keys) == 0 {
+		paths := sessionKeyFiles(cl)
+		if len(paths) == 0 {
+			logger.Warn("no session keys are configured; the session ids will not be valid after a restart")
+			return state.NewEphemeralSigner(), nil
+		}
+
+		logger.Warn("no session keys are configured; using the key kept alongside the sessions", "paths", paths)
+
+		var err error
+		if keys, err = state.LoadOrCreateKeys(paths...); err != nil {
+			return nil, err
+		}
+	}
+
+	return state.NewSigner(keys...)
+}
+
+// sessionKeyFiles returns the files keeping the generated session key next to the restored & the persisted sessions,
+// the restored ones first since their ids were signed with its key
+func sessionKeyFiles(cl CommandLineOptions) []string {
+	var paths []string
+
+	for _, path := range []string{cl.SessionsRestoreFrom, cl.SessionsPersistTo} {
+		if path != "" && (len(paths) == 0 || paths[0] != path+".key") {
+			paths = append(paths, path+".key")
+		}
+	}
+
+	return paths
+}
+
+// newCertificate loads or generates the tls certificate, if the service is configured to serve https
+func newCertificate(cl CommandLineOptions) (*certificate, error) {
+	switch {
+	case cl.TLSSelfSigned && (cl.TLSCert != "" || cl.TLSKey != ""):
+		return nil, errors.New("--tls-self-signed cannot be combined with --tls-cert & --tls-key")
+	case cl.TLSSelfSigned:
+		return selfSignedCertificate()
+	case cl.TLSCert == "" && cl.TLSKey == "":
+		return nil, nil
+	case cl.TLSCert == "" || cl.TLSKey == "":
+		return nil, errors.New("both --tls-cert & --tls-key are required to serve https")
+	default:
+		return loadCertificate(cl.TLSCert, cl.TLSKey)
+	}
+}
+
+// stopGracefully waits for the in-flight rpcs to finish until the context expires, then cancels the rest
+func stopGracefully(ctx context.Context, server *grpc.Server) error {
+	stopped := make(chan struct{})
+
+	go func() {
+		server.GracefulStop()
+		close(stopped)
+	}()
+
+	select {
+	case <-stopped:
+		return nil
+	case <-ctx.Done():
+		server.Stop()
+		return ctx.Err()
+	}
+}
+
+// textBodyDecoder passes text bodies through to the openapi validator as strings
+func textBodyDecoder(body io.Reader, _ http.Header, _ *openapi3.SchemaRef, _ openapi3filter.EncodingFn) (interface{}, error) {
+	b, err := ioutil.ReadAll(body)
+	if err != nil {
+		return nil, &openapi3filter.ParseError{Kind: openapi3filter.KindInvalidFormat, Cause: err}
+	}
+
+	return string(b), nil
+}
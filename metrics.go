@@ -0,0 +1,56 @@
+package main
+
+import (
+	"regexp"
+	"time"
+
+	"github.com/AntonAverchenkov/cards-http-service/internal/metrics"
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/labstack/echo/v4"
+)
+
+const metricsPath = "/metrics"
+
+var pathParameter = regexp.MustCompile(`{([^}]+)}`)
+
+// operationResolver maps the echo routes ("GET /card/:card") onto the api operation ids
+func operationResolver(swagger *openapi3.Swagger) func(ctx echo.Context) string {
+	operations := map[string]string{
+		"GET " + metricsPath: "Metrics",
+	}
+
+	for path, item := range swagger.Paths {
+		route := pathParameter.ReplaceAllString(path, ":$1")
+
+		for method, operation := range item.Operations() {
+			operations[method+" "+route] = operation.OperationID
+		}
+	}
+
+	return func(ctx echo.Context) string {
+		operation, ok := operations[ctx.Request().Method+" "+ctx.Path()]
+		if !ok {
+			return "Unknown"
+		}
+
+		return operation
+	}
+}
+
+// metricsMiddleware records the count & the latency of the requests by their api operation ids
+func metricsMiddleware(operation func(ctx echo.Context) string) echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(ctx echo.Context) error {
+			start := time.Now()
+
+			// let the error handler write the response, so that its status code is known
+			if err := next(ctx); err != nil {
+				ctx.Error(err)
+			}
+
+			metrics.ObserveRequest(operation(ctx), ctx.Response().Status, time.Since(start))
+
+			return nil
+		}
+	}
+}
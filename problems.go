@@ -0,0 +1,177 @@
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"net/http"
+	"strconv"
+
+	"github.com/AntonAverchenkov/cards-http-service/internal/api"
+	"github.com/AntonAverchenkov/cards-http-service/internal/game"
+	"github.com/AntonAverchenkov/cards-http-service/internal/game/gofish"
+	"github.com/AntonAverchenkov/cards-http-service/internal/game/klondike"
+	"github.com/AntonAverchenkov/cards-http-service/internal/game/war"
+	"github.com/AntonAverchenkov/cards-http-service/internal/limit"
+	"github.com/AntonAverchenkov/cards-http-service/internal/media"
+	"github.com/AntonAverchenkov/cards-http-service/internal/metrics"
+	"github.com/AntonAverchenkov/cards-http-service/internal/state"
+	"github.com/labstack/echo/v4"
+)
+
+const problemContentType = "application/problem+json"
+
+// problemTypes maps the stable problem codes onto their status codes & human-readable titles
+var problemTypes = map[api.ProblemCode]struct {
+	status int
+	title  string
+}{
+	api.ProblemCodeDeckEmpty:            {http.StatusConflict, "The deck is empty"},
+	api.ProblemCodeDeckFull:             {http.StatusConflict, "The deck is full"},
+	api.ProblemCodeCardDuplicate:        {http.StatusConflict, "The card already exists in the deck"},
+	api.ProblemCodeCardUnparseable:      {http.StatusBadRequest, "The card could not be parsed"},
+	api.ProblemCodeCardForeign:          {http.StatusConflict, "The card is not a part of the deck"},
+	api.ProblemCodeOrderInvalid:         {http.StatusBadRequest, "The order is invalid"},
+	api.ProblemCodeCardMissing:          {http.StatusConflict, "The card is not in the deck"},
+	api.ProblemCodeDeckShort:            {http.StatusConflict, "The deck is too short"},
+	api.ProblemCodeIndexOutOfRange:      {http.StatusConflict, "The index is out of range"},
+	api.ProblemCodeRequestInvalid:       {http.StatusBadRequest, "The request is invalid"},
+	api.ProblemCodeNotAcceptable:        {http.StatusNotAcceptable, "The representation is not acceptable"},
+	api.ProblemCodeMediaTypeUnsupported: {http.StatusUnsupportedMediaType, "The media type is not supported"},
+	api.ProblemCodeNotFound:             {http.StatusNotFound, "The resource could not be found"},
+	api.ProblemCodeMethodNotAllowed:     {http.StatusMethodNotAllowed, "The method is not allowed"},
+	api.ProblemCodeRateLimited:          {http.StatusTooManyRequests, "Too many requests"},
+	api.ProblemCodeUnauthorized:         {http.StatusUnauthorized, "Unauthorized"},
+	api.ProblemCodeSessionNotFound:      {http.StatusNotFound, "The session could not be found"},
+	api.ProblemCodeGameInProgress:       {http.StatusConflict, "A game is being played"},
+	api.ProblemCodeGameNotFound:         {http.StatusNotFound, "The game could not be found"},
+	api.ProblemCodeGameOver:             {http.StatusConflict, "The game is over"},
+	api.ProblemCodeMoveIllegal:          {http.StatusConflict, "The move is not allowed"},
+	api.ProblemCodePlayerNotFound:       {http.StatusNotFound, "The player could not be found"},
+	api.ProblemCodeInternalError:        {http.StatusInternalServerError, "Internal server error"},
+}
+
+// newProblem builds an RFC 7807 problem with the given code & detail
+func newProblem(code api.ProblemCode, detail string) api.Problem {
+	t := problemTypes[code]
+
+	return api.Problem{
+		Type:   "urn:cards-http-service:problem:" + string(code),
+		Title:  t.title,
+		Status: t.status,
+		Detail: detail,
+		Code:   code,
+	}
+}
+
+// problemFromError maps errors returned by the game package & echo onto problems
+func problemFromError(err error) api.Problem {
+	var httpErr *echo.HTTPError
+
+	switch {
+	case errors.Is(err, game.ErrDeckEmpty):
+		return newProblem(api.ProblemCodeDeckEmpty, err.Error())
+	case errors.Is(err, game.ErrDeckFull):
+		return newProblem(api.ProblemCodeDeckFull, err.Error())
+	case errors.Is(err, game.ErrCardDuplicate):
+		return newProblem(api.ProblemCodeCardDuplicate, err.Error())
+	case errors.Is(err, game.ErrCardForeign):
+		return newProblem(api.ProblemCodeCardForeign, err.Error())
+	case errors.Is(err, game.ErrOrderInvalid):
+		return newProblem(api.ProblemCodeOrderInvalid, err.Error())
+	case errors.Is(err, game.ErrCardMissing):
+		return newProblem(api.ProblemCodeCardMissing, err.Error())
+	case errors.Is(err, game.ErrDeckShort):
+		return newProblem(api.ProblemCodeDeckShort, err.Error())
+	case errors.Is(err, game.ErrIndexOutOfRange):
+		return newProblem(api.ProblemCodeIndexOutOfRange, err.Error())
+	case errors.Is(err, game.ErrCardUnparseable):
+		return newProblem(api.ProblemCodeCardUnparseable, err.Error())
+	case errors.Is(err, game.ErrUnparseable):
+		return newProblem(api.ProblemCodeRequestInvalid, err.Error())
+	case errors.Is(err, limit.ErrLimited):
+		return newProblem(api.ProblemCodeRateLimited, err.Error())
+	case errors.Is(err, errSessionNotFound):
+		return newProblem(api.ProblemCodeSessionNotFound, err.Error())
+	case errors.Is(err, state.ErrGameInProgress):
+		return newProblem(api.ProblemCodeGameInProgress, err.Error())
+	case errors.Is(err, state.ErrGameNotFound):
+		return newProblem(api.ProblemCodeGameNotFound, err.Error())
+	case errors.Is(err, war.ErrGameOver), errors.Is(err, klondike.ErrGameOver), errors.Is(err, gofish.ErrGameOver):
+		return newProblem(api.ProblemCodeGameOver, err.Error())
+	case errors.Is(err, klondike.ErrMoveIllegal), errors.Is(err, gofish.ErrMoveIllegal):
+		return newProblem(api.ProblemCodeMoveIllegal, err.Error())
+	case errors.Is(err, gofish.ErrPlayerNotFound):
+		return newProblem(api.ProblemCodePlayerNotFound, err.Error())
+	case errors.Is(err, gofish.ErrPlayersInvalid):
+		return newProblem(api.ProblemCodeRequestInvalid, err.Error())
+	case errors.Is(err, media.ErrNotAcceptable):
+		return newProblem(api.ProblemCodeNotAcceptable, err.Error())
+	case errors.Is(err, media.ErrUnsupportedMediaType):
+		return newProblem(api.ProblemCodeMediaTypeUnsupported, err.Error())
+	case errors.As(err, &httpErr):
+		return problemFromHTTPError(httpErr)
+	default:
+		return newProblem(api.ProblemCodeInternalError, err.Error())
+	}
+}
+
+// problemFromHTTPError handles the errors returned by echo's router, binder & the openapi validator middleware
+func problemFromHTTPError(err *echo.HTTPError) api.Problem {
+	detail, ok := err.Message.(string)
+	if !ok {
+		detail = http.StatusText(err.Code)
+	}
+
+	switch err.Code {
+	case http.StatusUnauthorized:
+		return newProblem(api.ProblemCodeUnauthorized, detail)
+	case http.StatusNotFound:
+		return newProblem(api.ProblemCodeNotFound, detail)
+	case http.StatusMethodNotAllowed:
+		return newProblem(api.ProblemCodeMethodNotAllowed, detail)
+	case http.StatusUnsupportedMediaType:
+		return newProblem(api.ProblemCodeMediaTypeUnsupported, detail)
+	case http.StatusInternalServerError:
+		return newProblem(api.ProblemCodeInternalError, detail)
+	default:
+		p := newProblem(api.ProblemCodeRequestInvalid, detail)
+		p.Status = err.Code
+		return p
+	}
+}
+
+// Problem writes the given error as an 'application/problem+json' response
+func Problem(ctx echo.Context, err error) error {
+	p := problemFromError(err)
+
+	if p.Status == http.StatusConflict {
+		metrics.Conflict(string(p.Code))
+	}
+
+	// let the client know when the budget is refilled
+	var limitErr *limit.Error
+	if errors.As(err, &limitErr) {
+		metrics.RateLimited(limitErr.Budget)
+		ctx.Response().Header().Set("Retry-After", strconv.Itoa(limitErr.RetryAfterSeconds()))
+	}
+
+	b, err := json.MarshalIndent(p, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	return ctx.Blob(p.Status, problemContentType, b)
+}
+
+// problemErrorHandler replaces echo's default error handler so that the errors
+// returned from the middleware (including the openapi validator) are formatted as problems
+func problemErrorHandler(err error, ctx echo.Context) {
+	if ctx.Response().Committed {
+		return
+	}
+
+	if err := Problem(ctx, err); err != nil {
+		slog.Error("could not write the problem", "request_id", requestID(ctx), "error", err)
+	}
+}
@@ -0,0 +1,113 @@
+package main
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"fmt"
+	"math/big"
+	"net"
+	"sync"
+	"time"
+)
+
+// certificate serves the tls certificate to the listeners & allows replacing it without a restart
+type certificate struct {
+	lock sync.RWMutex
+	cert *tls.Certificate
+
+	certPath string
+	keyPath  string
+}
+
+// loadCertificate reads the pem-encoded certificate & key pair from the given files
+func loadCertificate(certPath, keyPath string) (*certificate, error) {
+	c := &certificate{
+		certPath: certPath,
+		keyPath:  keyPath,
+	}
+
+	if err := c.Reload(); err != nil {
+		return nil, err
+	}
+
+	return c, nil
+}
+
+// selfSignedCertificate generates a short-lived certificate for localhost in memory (for the development only)
+func selfSignedCertificate() (*certificate, error) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		return nil, fmt.Errorf("could not generate a key: %w", err)
+	}
+
+	serial, err := rand.Int(rand.Reader, new(big.Int).Lsh(big.NewInt(1), 128))
+	if err != nil {
+		return nil, fmt.Errorf("could not generate a serial number: %w", err)
+	}
+
+	now := time.Now()
+
+	template := x509.Certificate{
+		SerialNumber:          serial,
+		Subject:               pkix.Name{Organization: []string{"cards-http-service"}},
+		NotBefore:             now.Add(-time.Hour),
+		NotAfter:              now.Add(30 * 24 * time.Hour),
+		KeyUsage:              x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:           []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+		BasicConstraintsValid: true,
+		DNSNames:              []string{"localhost"},
+		IPAddresses:           []net.IP{net.IPv4(127, 0, 0, 1), net.IPv6loopback},
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, &template, &template, &key.PublicKey, key)
+	if err != nil {
+		return nil, fmt.Errorf("could not create a certificate: %w", err)
+	}
+
+	return &certificate{
+		cert: &tls.Certificate{
+			Certificate: [][]byte{der},
+			PrivateKey:  key,
+		},
+	}, nil
+}
+
+// Reload re-reads the certificate files; the previous certificate is kept if they could not be loaded
+func (c *certificate) Reload() error {
+	if c.certPath == "" {
+		return nil
+	}
+
+	cert, err := tls.LoadX509KeyPair(c.certPath, c.keyPath)
+	if err != nil {
+		return fmt.Errorf("could not load the certificate from %q & %q: %w", c.certPath, c.keyPath, err)
+	}
+
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	c.cert = &cert
+
+	return nil
+}
+
+// GetCertificate implements tls.Config.GetCertificate
+func (c *certificate) GetCertificate(*tls.ClientHelloInfo) (*tls.Certificate, error) {
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+
+	return c.cert, nil
+}
+
+// TLSConfig returns a server config that negotiates http/2 & picks up the reloaded certificates
+func (c *certificate) TLSConfig() *tls.Config {
+	return &tls.Config{
+		MinVersion:     tls.VersionTLS12,
+		GetCertificate: c.GetCertificate,
+		NextProtos:     []string{"h2", "http/1.1"},
+	}
+}
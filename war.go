@@ -0,0 +1,149 @@
+package main
+
+import (
+	"net/http"
+
+	"github.com/AntonAverchenkov/cards-http-service/internal/api"
+	"github.com/AntonAverchenkov/cards-http-service/internal/game/war"
+	"github.com/AntonAverchenkov/cards-http-service/internal/state"
+	"github.com/labstack/echo/v4"
+)
+
+// (GET /games/war) : get the state of the game of war
+func (h *handlers) WarShow(ctx echo.Context) error {
+	h.lock.Lock()
+	defer h.lock.Unlock()
+
+	session, err := h.fetchSessionSetCookie(ctx)
+	if err != nil {
+		return Problem(ctx, err)
+	}
+
+	g, err := warGame(session)
+	if err != nil {
+		return Problem(ctx, err)
+	}
+
+	return JSON(ctx, http.StatusOK, fromWarGame(g))
+}
+
+// (POST /games/war) : start a game of war by dealing the deck's cards into two piles
+func (h *handlers) WarStart(ctx echo.Context) error {
+	h.lock.Lock()
+	defer h.lock.Unlock()
+
+	session, err := h.fetchMutableSession(ctx)
+	if err != nil {
+		return Problem(ctx, err)
+	}
+
+	g, err := war.New(session.Deck.Cards)
+	if err != nil {
+		return Problem(ctx, err)
+	}
+
+	// the cards stay out of the deck until the game is ended
+	session.Deck.Cards = nil
+	h.sessions.SetGame(session.Id, g)
+
+	return JSON(ctx, http.StatusCreated, fromWarGame(g))
+}
+
+// (POST /games/war/step) : play a single round of the game of war
+func (h *handlers) WarStep(ctx echo.Context) error {
+	h.lock.Lock()
+	defer h.lock.Unlock()
+
+	session, err := h.fetchPlayingSession(ctx)
+	if err != nil {
+		return Problem(ctx, err)
+	}
+
+	g, err := warGame(session)
+	if err != nil {
+		return Problem(ctx, err)
+	}
+
+	round, err := g.Step()
+	if err != nil {
+		return Problem(ctx, err)
+	}
+
+	return JSON(ctx, http.StatusOK, api.WarRound{
+		Number: round.Number,
+		Played: [][]api.Card{fromGameCards(round.Played[0]), fromGameCards(round.Played[1])},
+		Wars:   round.Wars,
+		Winner: round.Winner,
+		Game:   fromWarGame(g),
+	})
+}
+
+// (POST /games/war/play) : play the game of war until it is over
+func (h *handlers) WarPlay(ctx echo.Context) error {
+	h.lock.Lock()
+	defer h.lock.Unlock()
+
+	session, err := h.fetchPlayingSession(ctx)
+	if err != nil {
+		return Problem(ctx, err)
+	}
+
+	g, err := warGame(session)
+	if err != nil {
+		return Problem(ctx, err)
+	}
+
+	g.Play()
+
+	return JSON(ctx, http.StatusOK, fromWarGame(g))
+}
+
+// (DELETE /games/war) : end the game of war & put the cards back into the deck
+func (h *handlers) WarEnd(ctx echo.Context) error {
+	format, err := negotiate(ctx)
+	if err != nil {
+		return Problem(ctx, err)
+	}
+
+	h.lock.Lock()
+	defer h.lock.Unlock()
+
+	session, err := h.fetchPlayingSession(ctx)
+	if err != nil {
+		return Problem(ctx, err)
+	}
+
+	g, err := warGame(session)
+	if err != nil {
+		return Problem(ctx, err)
+	}
+
+	session.Deck.Cards = append(session.Deck.Cards, g.Cards()...)
+	h.sessions.SetGame(session.Id, nil)
+
+	return Cards(ctx, http.StatusOK, format, session.Deck.Cards)
+}
+
+// warGame returns the game of war being played in the session
+func warGame(session state.Session) (*war.Game, error) {
+	g, ok := session.Game.(*war.Game)
+	if !ok {
+		return nil, state.ErrGameNotFound
+	}
+
+	return g, nil
+}
+
+func fromWarGame(g *war.Game) api.WarGame {
+	result := api.WarGame{
+		Status: api.WarGameStatus(g.Status().String()),
+		Rounds: g.Rounds,
+		Piles:  []int{len(g.Piles[0]), len(g.Piles[1])},
+	}
+
+	if winner := g.Winner(); winner != 0 {
+		result.Winner = &winner
+	}
+
+	return result
+}